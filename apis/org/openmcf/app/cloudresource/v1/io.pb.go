@@ -421,7 +421,11 @@ func (x *UpdateCloudResourceResponse) GetResource() *CloudResource {
 type DeleteCloudResourceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the cloud resource to delete.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Destroy the infrastructure of the cloud resource before deleting the record.
+	// The record is deleted once the destroy stack-update succeeds.
+	// Without it, the record is only deleted when no deployed resources remain.
+	Destroy       bool `protobuf:"varint,2,opt,name=destroy,proto3" json:"destroy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -463,11 +467,20 @@ func (x *DeleteCloudResourceRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteCloudResourceRequest) GetDestroy() bool {
+	if x != nil {
+		return x.Destroy
+	}
+	return false
+}
+
 // Response message for delete operation.
 type DeleteCloudResourceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Success status message.
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The destroy stack-update, set when destroy was requested.
+	StackUpdateId string `protobuf:"bytes,2,opt,name=stack_update_id,json=stackUpdateId,proto3" json:"stack_update_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -509,6 +522,13 @@ func (x *DeleteCloudResourceResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteCloudResourceResponse) GetStackUpdateId() string {
+	if x != nil {
+		return x.StackUpdateId
+	}
+	return ""
+}
+
 // Request message for applying a cloud resource (upsert).
 type ApplyCloudResourceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -726,11 +746,13 @@ const file_org_openmcf_app_cloudresource_v1_io_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bmanifest\x18\x02 \x01(\tR\bmanifest\"j\n" +
 	"\x1bUpdateCloudResourceResponse\x12K\n" +
-	"\bresource\x18\x01 \x01(\v2/.org.openmcf.app.cloudresource.v1.CloudResourceR\bresource\",\n" +
+	"\bresource\x18\x01 \x01(\v2/.org.openmcf.app.cloudresource.v1.CloudResourceR\bresource\"F\n" +
 	"\x1aDeleteCloudResourceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adestroy\x18\x02 \x01(\bR\adestroy\"_\n" +
 	"\x1bDeleteCloudResourceResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"7\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12&\n" +
+	"\x0fstack_update_id\x18\x02 \x01(\tR\rstackUpdateId\"7\n" +
 	"\x19ApplyCloudResourceRequest\x12\x1a\n" +
 	"\bmanifest\x18\x01 \x01(\tR\bmanifest\"\x83\x01\n" +
 	"\x1aApplyCloudResourceResponse\x12K\n" +
@@ -44,17 +44,31 @@ const (
 	Credential_AZURE                           Credential_CredentialProvider = 3
 	Credential_AUTH0                           Credential_CredentialProvider = 4
 	Credential_OPEN_FGA                        Credential_CredentialProvider = 5
+	Credential_ATLAS                           Credential_CredentialProvider = 6
+	Credential_CIVO                            Credential_CredentialProvider = 7
+	Credential_CLOUDFLARE                      Credential_CredentialProvider = 8
+	Credential_CONFLUENT                       Credential_CredentialProvider = 9
+	Credential_DIGITAL_OCEAN                   Credential_CredentialProvider = 10
+	Credential_KUBERNETES                      Credential_CredentialProvider = 11
+	Credential_SNOWFLAKE                       Credential_CredentialProvider = 12
 )
 
 // Enum value maps for Credential_CredentialProvider.
 var (
 	Credential_CredentialProvider_name = map[int32]string{
-		0: "CREDENTIAL_PROVIDER_UNSPECIFIED",
-		1: "GCP",
-		2: "AWS",
-		3: "AZURE",
-		4: "AUTH0",
-		5: "OPEN_FGA",
+		0:  "CREDENTIAL_PROVIDER_UNSPECIFIED",
+		1:  "GCP",
+		2:  "AWS",
+		3:  "AZURE",
+		4:  "AUTH0",
+		5:  "OPEN_FGA",
+		6:  "ATLAS",
+		7:  "CIVO",
+		8:  "CLOUDFLARE",
+		9:  "CONFLUENT",
+		10: "DIGITAL_OCEAN",
+		11: "KUBERNETES",
+		12: "SNOWFLAKE",
 	}
 	Credential_CredentialProvider_value = map[string]int32{
 		"CREDENTIAL_PROVIDER_UNSPECIFIED": 0,
@@ -63,6 +77,13 @@ var (
 		"AZURE":                           3,
 		"AUTH0":                           4,
 		"OPEN_FGA":                        5,
+		"ATLAS":                           6,
+		"CIVO":                            7,
+		"CLOUDFLARE":                      8,
+		"CONFLUENT":                       9,
+		"DIGITAL_OCEAN":                   10,
+		"KUBERNETES":                      11,
+		"SNOWFLAKE":                       12,
 	}
 )
 
@@ -103,6 +124,9 @@ type Credential struct {
 	// Provider type.
 	Provider       Credential_CredentialProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=org.openmcf.credential.v1.Credential_CredentialProvider" json:"provider,omitempty"`
 	ProviderConfig *CredentialProviderConfig     `protobuf:"bytes,4,opt,name=provider_config,json=providerConfig,proto3" json:"provider_config,omitempty"`
+	// Environments (metadata.env of a manifest) this credential is the default for.
+	// A deployment in one of these environments uses this credential unless it names another.
+	DefaultForEnvs []string `protobuf:"bytes,5,rep,name=default_for_envs,json=defaultForEnvs,proto3" json:"default_for_envs,omitempty"`
 	// Creation timestamp.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
@@ -169,6 +193,13 @@ func (x *Credential) GetProviderConfig() *CredentialProviderConfig {
 	return nil
 }
 
+func (x *Credential) GetDefaultForEnvs() []string {
+	if x != nil {
+		return x.DefaultForEnvs
+	}
+	return nil
+}
+
 func (x *Credential) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -431,24 +462,35 @@ var File_org_openmcf_app_credential_v1_api_proto protoreflect.FileDescriptor
 
 const file_org_openmcf_app_credential_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"'org/openmcf/app/credential/v1/api.proto\x12\x19org.openmcf.credential.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)org/openmcf/provider/atlas/provider.proto\x1a)org/openmcf/provider/auth0/provider.proto\x1a'org/openmcf/provider/aws/provider.proto\x1a)org/openmcf/provider/azure/provider.proto\x1a(org/openmcf/provider/civo/provider.proto\x1a.org/openmcf/provider/cloudflare/provider.proto\x1a-org/openmcf/provider/confluent/provider.proto\x1a0org/openmcf/provider/digitalocean/provider.proto\x1a'org/openmcf/provider/gcp/provider.proto\x1a.org/openmcf/provider/kubernetes/provider.proto\x1a+org/openmcf/provider/openfga/provider.proto\x1a-org/openmcf/provider/snowflake/provider.proto\"\xcb\x03\n" +
+	"'org/openmcf/app/credential/v1/api.proto\x12\x19org.openmcf.credential.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)org/openmcf/provider/atlas/provider.proto\x1a)org/openmcf/provider/auth0/provider.proto\x1a'org/openmcf/provider/aws/provider.proto\x1a)org/openmcf/provider/azure/provider.proto\x1a(org/openmcf/provider/civo/provider.proto\x1a.org/openmcf/provider/cloudflare/provider.proto\x1a-org/openmcf/provider/confluent/provider.proto\x1a0org/openmcf/provider/digitalocean/provider.proto\x1a'org/openmcf/provider/gcp/provider.proto\x1a.org/openmcf/provider/kubernetes/provider.proto\x1a+org/openmcf/provider/openfga/provider.proto\x1a-org/openmcf/provider/snowflake/provider.proto\"\xdc\x04\n" +
 	"\n" +
 	"Credential\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12T\n" +
 	"\bprovider\x18\x03 \x01(\x0e28.org.openmcf.credential.v1.Credential.CredentialProviderR\bprovider\x12\\\n" +
-	"\x0fprovider_config\x18\x04 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\x129\n" +
+	"\x0fprovider_config\x18\x04 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\x12(\n" +
+	"\x10default_for_envs\x18\x05 \x03(\tR\x0edefaultForEnvs\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"o\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd5\x01\n" +
 	"\x12CredentialProvider\x12#\n" +
 	"\x1fCREDENTIAL_PROVIDER_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03GCP\x10\x01\x12\a\n" +
 	"\x03AWS\x10\x02\x12\t\n" +
 	"\x05AZURE\x10\x03\x12\t\n" +
 	"\x05AUTH0\x10\x04\x12\f\n" +
-	"\bOPEN_FGA\x10\x05\"\xe6\a\n" +
+	"\bOPEN_FGA\x10\x05\x12\t\n" +
+	"\x05ATLAS\x10\x06\x12\b\n" +
+	"\x04CIVO\x10\a\x12\x0e\n" +
+	"\n" +
+	"CLOUDFLARE\x10\b\x12\r\n" +
+	"\tCONFLUENT\x10\t\x12\x11\n" +
+	"\rDIGITAL_OCEAN\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"KUBERNETES\x10\v\x12\r\n" +
+	"\tSNOWFLAKE\x10\f\"\xe6\a\n" +
 	"\x18CredentialProviderConfig\x12G\n" +
 	"\x05atlas\x18\x01 \x01(\v2/.org.openmcf.provider.atlas.AtlasProviderConfigH\x00R\x05atlas\x12?\n" +
 	"\x03aws\x18\x02 \x01(\v2+.org.openmcf.provider.aws.AwsProviderConfigH\x00R\x03aws\x12G\n" +
@@ -27,9 +27,11 @@ type CreateCredentialRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Name of the credential.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Provider type (gcp, aws, azure).
+	// Provider type.
 	Provider       Credential_CredentialProvider `protobuf:"varint,2,opt,name=provider,proto3,enum=org.openmcf.credential.v1.Credential_CredentialProvider" json:"provider,omitempty"`
 	ProviderConfig *CredentialProviderConfig     `protobuf:"bytes,3,opt,name=provider_config,json=providerConfig,proto3" json:"provider_config,omitempty"`
+	// Environments this credential is the default for.
+	DefaultForEnvs []string `protobuf:"bytes,4,rep,name=default_for_envs,json=defaultForEnvs,proto3" json:"default_for_envs,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -85,6 +87,13 @@ func (x *CreateCredentialRequest) GetProviderConfig() *CredentialProviderConfig
 	return nil
 }
 
+func (x *CreateCredentialRequest) GetDefaultForEnvs() []string {
+	if x != nil {
+		return x.DefaultForEnvs
+	}
+	return nil
+}
+
 // Response message for creating a credential.
 type CreateCredentialResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -134,7 +143,7 @@ func (x *CreateCredentialResponse) GetCredential() *Credential {
 // Request message for listing credentials.
 type ListCredentialsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional filter by provider (gcp, aws, azure).
+	// Optional filter by provider.
 	Provider      Credential_CredentialProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=org.openmcf.credential.v1.Credential_CredentialProvider" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -235,9 +244,11 @@ type CredentialSummary struct {
 	// Creation timestamp.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Environments this credential is the default for.
+	DefaultForEnvs []string `protobuf:"bytes,6,rep,name=default_for_envs,json=defaultForEnvs,proto3" json:"default_for_envs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CredentialSummary) Reset() {
@@ -305,11 +316,20 @@ func (x *CredentialSummary) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CredentialSummary) GetDefaultForEnvs() []string {
+	if x != nil {
+		return x.DefaultForEnvs
+	}
+	return nil
+}
+
 // Request message for getting a credential by ID.
 type GetCredentialRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the credential.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Return secret fields in plaintext. Secret fields are replaced with a placeholder unless this is set.
+	RevealSecrets bool `protobuf:"varint,2,opt,name=reveal_secrets,json=revealSecrets,proto3" json:"reveal_secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,6 +371,13 @@ func (x *GetCredentialRequest) GetId() string {
 	return ""
 }
 
+func (x *GetCredentialRequest) GetRevealSecrets() bool {
+	if x != nil {
+		return x.RevealSecrets
+	}
+	return false
+}
+
 // Response message containing the retrieved credential.
 type GetCredentialResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -408,6 +435,8 @@ type UpdateCredentialRequest struct {
 	Provider Credential_CredentialProvider `protobuf:"varint,3,opt,name=provider,proto3,enum=org.openmcf.credential.v1.Credential_CredentialProvider" json:"provider,omitempty"`
 	// Provider-specific credential data.
 	ProviderConfig *CredentialProviderConfig `protobuf:"bytes,4,opt,name=provider_config,json=providerConfig,proto3" json:"provider_config,omitempty"`
+	// Environments this credential is the default for. Replaces the existing list.
+	DefaultForEnvs []string `protobuf:"bytes,5,rep,name=default_for_envs,json=defaultForEnvs,proto3" json:"default_for_envs,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -470,6 +499,13 @@ func (x *UpdateCredentialRequest) GetProviderConfig() *CredentialProviderConfig
 	return nil
 }
 
+func (x *UpdateCredentialRequest) GetDefaultForEnvs() []string {
+	if x != nil {
+		return x.DefaultForEnvs
+	}
+	return nil
+}
+
 // Response message containing the updated credential.
 type UpdateCredentialResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -612,11 +648,12 @@ var File_org_openmcf_app_credential_v1_io_proto protoreflect.FileDescriptor
 
 const file_org_openmcf_app_credential_v1_io_proto_rawDesc = "" +
 	"\n" +
-	"&org/openmcf/app/credential/v1/io.proto\x12\x19org.openmcf.credential.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a'org/openmcf/app/credential/v1/api.proto\"\xe1\x01\n" +
+	"&org/openmcf/app/credential/v1/io.proto\x12\x19org.openmcf.credential.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a'org/openmcf/app/credential/v1/api.proto\"\x8b\x02\n" +
 	"\x17CreateCredentialRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12T\n" +
 	"\bprovider\x18\x02 \x01(\x0e28.org.openmcf.credential.v1.Credential.CredentialProviderR\bprovider\x12\\\n" +
-	"\x0fprovider_config\x18\x03 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\"a\n" +
+	"\x0fprovider_config\x18\x03 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\x12(\n" +
+	"\x10default_for_envs\x18\x04 \x03(\tR\x0edefaultForEnvs\"a\n" +
 	"\x18CreateCredentialResponse\x12E\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2%.org.openmcf.credential.v1.CredentialR\n" +
@@ -624,7 +661,7 @@ const file_org_openmcf_app_credential_v1_io_proto_rawDesc = "" +
 	"\x16ListCredentialsRequest\x12T\n" +
 	"\bprovider\x18\x01 \x01(\x0e28.org.openmcf.credential.v1.Credential.CredentialProviderR\bprovider\"i\n" +
 	"\x17ListCredentialsResponse\x12N\n" +
-	"\vcredentials\x18\x01 \x03(\v2,.org.openmcf.credential.v1.CredentialSummaryR\vcredentials\"\x83\x02\n" +
+	"\vcredentials\x18\x01 \x03(\v2,.org.openmcf.credential.v1.CredentialSummaryR\vcredentials\"\xad\x02\n" +
 	"\x11CredentialSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12T\n" +
@@ -632,18 +669,21 @@ const file_org_openmcf_app_credential_v1_io_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"&\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x10default_for_envs\x18\x06 \x03(\tR\x0edefaultForEnvs\"M\n" +
 	"\x14GetCredentialRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ereveal_secrets\x18\x02 \x01(\bR\rrevealSecrets\"^\n" +
 	"\x15GetCredentialResponse\x12E\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2%.org.openmcf.credential.v1.CredentialR\n" +
-	"credential\"\xf1\x01\n" +
+	"credential\"\x9b\x02\n" +
 	"\x17UpdateCredentialRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12T\n" +
 	"\bprovider\x18\x03 \x01(\x0e28.org.openmcf.credential.v1.Credential.CredentialProviderR\bprovider\x12\\\n" +
-	"\x0fprovider_config\x18\x04 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\"a\n" +
+	"\x0fprovider_config\x18\x04 \x01(\v23.org.openmcf.credential.v1.CredentialProviderConfigR\x0eproviderConfig\x12(\n" +
+	"\x10default_for_envs\x18\x05 \x03(\tR\x0edefaultForEnvs\"a\n" +
 	"\x18UpdateCredentialResponse\x12E\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2%.org.openmcf.credential.v1.CredentialR\n" +
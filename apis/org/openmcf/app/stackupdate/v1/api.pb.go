@@ -32,12 +32,19 @@ type StackUpdate struct {
 	CloudResourceId string `protobuf:"bytes,2,opt,name=cloud_resource_id,json=cloudResourceId,proto3" json:"cloud_resource_id,omitempty"`
 	// The status of the deployment (success, failed, in_progress).
 	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	// Pulumi deployment output as JSON string containing: status, stdout, stderr, exit_code, timestamp, stack_fqdn, error
+	// Deployment output as JSON string containing: status, stdout, stderr, exit_code, timestamp, stack_fqdn, error
 	Output string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
 	// Timestamp when the stack-update was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the stack-update was last updated.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The IaC provisioner that ran the stack-update (pulumi, tofu or terraform).
+	// Taken from the openmcf.org/provisioner manifest label, defaulting to pulumi.
+	Provisioner string `protobuf:"bytes,7,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	// The operation the stack-update ran (deploy, destroy, preview or refresh).
+	Operation string `protobuf:"bytes,8,opt,name=operation,proto3" json:"operation,omitempty"`
+	// ID of the credential the stack-update ran with.
+	CredentialId  string `protobuf:"bytes,9,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,11 +121,32 @@ func (x *StackUpdate) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StackUpdate) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
+	}
+	return ""
+}
+
+func (x *StackUpdate) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *StackUpdate) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
 var File_org_openmcf_app_stackupdate_v1_api_proto protoreflect.FileDescriptor
 
 const file_org_openmcf_app_stackupdate_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"(org/openmcf/app/stackupdate/v1/api.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
+	"(org/openmcf/app/stackupdate/v1/api.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x02\n" +
 	"\vStackUpdate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
 	"\x11cloud_resource_id\x18\x02 \x01(\tR\x0fcloudResourceId\x12\x16\n" +
@@ -127,7 +155,10 @@ const file_org_openmcf_app_stackupdate_v1_api_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x9b\x02\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\vprovisioner\x18\a \x01(\tR\vprovisioner\x12\x1c\n" +
+	"\toperation\x18\b \x01(\tR\toperation\x12#\n" +
+	"\rcredential_id\x18\t \x01(\tR\fcredentialIdB\x9b\x02\n" +
 	"\"com.org.openmcf.app.stackupdate.v1B\bApiProtoP\x01ZNgithub.com/plantonhq/openmcf/apis/org/openmcf/app/stackupdate/v1;stackupdatev1\xa2\x02\x04OOAS\xaa\x02\x1eOrg.Openmcf.App.Stackupdate.V1\xca\x02\x1eOrg\\Openmcf\\App\\Stackupdate\\V1\xe2\x02*Org\\Openmcf\\App\\Stackupdate\\V1\\GPBMetadata\xea\x02\"Org::Openmcf::App::Stackupdate::V1b\x06proto3"
 
 var (
@@ -24,20 +24,35 @@ var File_org_openmcf_app_stackupdate_v1_command_proto protoreflect.FileDescripto
 
 const file_org_openmcf_app_stackupdate_v1_command_proto_rawDesc = "" +
 	"\n" +
-	",org/openmcf/app/stackupdate/v1/command.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a'org/openmcf/app/stackupdate/v1/io.proto2\xaf\x01\n" +
+	",org/openmcf/app/stackupdate/v1/command.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a'org/openmcf/app/stackupdate/v1/io.proto2\xeb\x04\n" +
 	"\x1cStackUpdateCommandController\x12\x8e\x01\n" +
-	"\x13DeployCloudResource\x12:.org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest\x1a;.org.openmcf.app.stackupdate.v1.DeployCloudResourceResponseB\x9f\x02\n" +
+	"\x13DeployCloudResource\x12:.org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest\x1a;.org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse\x12\x91\x01\n" +
+	"\x14DestroyCloudResource\x12;.org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest\x1a<.org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse\x12\x91\x01\n" +
+	"\x14PreviewCloudResource\x12;.org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest\x1a<.org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse\x12\x91\x01\n" +
+	"\x14RefreshCloudResource\x12;.org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest\x1a<.org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponseB\x9f\x02\n" +
 	"\"com.org.openmcf.app.stackupdate.v1B\fCommandProtoP\x01ZNgithub.com/plantonhq/openmcf/apis/org/openmcf/app/stackupdate/v1;stackupdatev1\xa2\x02\x04OOAS\xaa\x02\x1eOrg.Openmcf.App.Stackupdate.V1\xca\x02\x1eOrg\\Openmcf\\App\\Stackupdate\\V1\xe2\x02*Org\\Openmcf\\App\\Stackupdate\\V1\\GPBMetadata\xea\x02\"Org::Openmcf::App::Stackupdate::V1b\x06proto3"
 
 var file_org_openmcf_app_stackupdate_v1_command_proto_goTypes = []any{
-	(*DeployCloudResourceRequest)(nil),  // 0: org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest
-	(*DeployCloudResourceResponse)(nil), // 1: org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse
+	(*DeployCloudResourceRequest)(nil),   // 0: org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest
+	(*DestroyCloudResourceRequest)(nil),  // 1: org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest
+	(*PreviewCloudResourceRequest)(nil),  // 2: org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest
+	(*RefreshCloudResourceRequest)(nil),  // 3: org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest
+	(*DeployCloudResourceResponse)(nil),  // 4: org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse
+	(*DestroyCloudResourceResponse)(nil), // 5: org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse
+	(*PreviewCloudResourceResponse)(nil), // 6: org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse
+	(*RefreshCloudResourceResponse)(nil), // 7: org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse
 }
 var file_org_openmcf_app_stackupdate_v1_command_proto_depIdxs = []int32{
 	0, // 0: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DeployCloudResource:input_type -> org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest
-	1, // 1: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DeployCloudResource:output_type -> org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	1, // 1: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DestroyCloudResource:input_type -> org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest
+	2, // 2: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.PreviewCloudResource:input_type -> org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest
+	3, // 3: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.RefreshCloudResource:input_type -> org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest
+	4, // 4: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DeployCloudResource:output_type -> org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse
+	5, // 5: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DestroyCloudResource:output_type -> org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse
+	6, // 6: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.PreviewCloudResource:output_type -> org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse
+	7, // 7: org.openmcf.app.stackupdate.v1.StackUpdateCommandController.RefreshCloudResource:output_type -> org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StackUpdateCommandController_DeployCloudResource_FullMethodName = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/DeployCloudResource"
+	StackUpdateCommandController_DeployCloudResource_FullMethodName  = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/DeployCloudResource"
+	StackUpdateCommandController_DestroyCloudResource_FullMethodName = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/DestroyCloudResource"
+	StackUpdateCommandController_PreviewCloudResource_FullMethodName = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/PreviewCloudResource"
+	StackUpdateCommandController_RefreshCloudResource_FullMethodName = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/RefreshCloudResource"
 )
 
 // StackUpdateCommandControllerClient is the client API for StackUpdateCommandController service.
@@ -28,9 +31,18 @@ const (
 //
 // StackUpdateCommandController provides operations for managing pulumi/terraform stack updates.
 type StackUpdateCommandControllerClient interface {
-	// DeployCloudResource deploys a cloud resource using Pulumi.
-	// Takes a cloud resource ID, fetches the manifest, executes pulumi up, and stores the result in stackupdates table.
+	// DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+	// Takes a cloud resource ID, fetches the manifest, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
 	DeployCloudResource(ctx context.Context, in *DeployCloudResourceRequest, opts ...grpc.CallOption) (*DeployCloudResourceResponse, error)
+	// DestroyCloudResource destroys the infrastructure of a cloud resource.
+	// Runs pulumi destroy or tofu/terraform destroy and keeps the cloud resource record.
+	DestroyCloudResource(ctx context.Context, in *DestroyCloudResourceRequest, opts ...grpc.CallOption) (*DestroyCloudResourceResponse, error)
+	// PreviewCloudResource previews the changes a deploy would make without applying them.
+	// Runs pulumi preview or tofu/terraform plan.
+	PreviewCloudResource(ctx context.Context, in *PreviewCloudResourceRequest, opts ...grpc.CallOption) (*PreviewCloudResourceResponse, error)
+	// RefreshCloudResource syncs the stack state with the real infrastructure.
+	// Runs pulumi refresh or tofu/terraform refresh.
+	RefreshCloudResource(ctx context.Context, in *RefreshCloudResourceRequest, opts ...grpc.CallOption) (*RefreshCloudResourceResponse, error)
 }
 
 type stackUpdateCommandControllerClient struct {
@@ -51,15 +63,54 @@ func (c *stackUpdateCommandControllerClient) DeployCloudResource(ctx context.Con
 	return out, nil
 }
 
+func (c *stackUpdateCommandControllerClient) DestroyCloudResource(ctx context.Context, in *DestroyCloudResourceRequest, opts ...grpc.CallOption) (*DestroyCloudResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DestroyCloudResourceResponse)
+	err := c.cc.Invoke(ctx, StackUpdateCommandController_DestroyCloudResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stackUpdateCommandControllerClient) PreviewCloudResource(ctx context.Context, in *PreviewCloudResourceRequest, opts ...grpc.CallOption) (*PreviewCloudResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCloudResourceResponse)
+	err := c.cc.Invoke(ctx, StackUpdateCommandController_PreviewCloudResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stackUpdateCommandControllerClient) RefreshCloudResource(ctx context.Context, in *RefreshCloudResourceRequest, opts ...grpc.CallOption) (*RefreshCloudResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshCloudResourceResponse)
+	err := c.cc.Invoke(ctx, StackUpdateCommandController_RefreshCloudResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StackUpdateCommandControllerServer is the server API for StackUpdateCommandController service.
 // All implementations must embed UnimplementedStackUpdateCommandControllerServer
 // for forward compatibility.
 //
 // StackUpdateCommandController provides operations for managing pulumi/terraform stack updates.
 type StackUpdateCommandControllerServer interface {
-	// DeployCloudResource deploys a cloud resource using Pulumi.
-	// Takes a cloud resource ID, fetches the manifest, executes pulumi up, and stores the result in stackupdates table.
+	// DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+	// Takes a cloud resource ID, fetches the manifest, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
 	DeployCloudResource(context.Context, *DeployCloudResourceRequest) (*DeployCloudResourceResponse, error)
+	// DestroyCloudResource destroys the infrastructure of a cloud resource.
+	// Runs pulumi destroy or tofu/terraform destroy and keeps the cloud resource record.
+	DestroyCloudResource(context.Context, *DestroyCloudResourceRequest) (*DestroyCloudResourceResponse, error)
+	// PreviewCloudResource previews the changes a deploy would make without applying them.
+	// Runs pulumi preview or tofu/terraform plan.
+	PreviewCloudResource(context.Context, *PreviewCloudResourceRequest) (*PreviewCloudResourceResponse, error)
+	// RefreshCloudResource syncs the stack state with the real infrastructure.
+	// Runs pulumi refresh or tofu/terraform refresh.
+	RefreshCloudResource(context.Context, *RefreshCloudResourceRequest) (*RefreshCloudResourceResponse, error)
 	mustEmbedUnimplementedStackUpdateCommandControllerServer()
 }
 
@@ -73,6 +124,15 @@ type UnimplementedStackUpdateCommandControllerServer struct{}
 func (UnimplementedStackUpdateCommandControllerServer) DeployCloudResource(context.Context, *DeployCloudResourceRequest) (*DeployCloudResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployCloudResource not implemented")
 }
+func (UnimplementedStackUpdateCommandControllerServer) DestroyCloudResource(context.Context, *DestroyCloudResourceRequest) (*DestroyCloudResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyCloudResource not implemented")
+}
+func (UnimplementedStackUpdateCommandControllerServer) PreviewCloudResource(context.Context, *PreviewCloudResourceRequest) (*PreviewCloudResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCloudResource not implemented")
+}
+func (UnimplementedStackUpdateCommandControllerServer) RefreshCloudResource(context.Context, *RefreshCloudResourceRequest) (*RefreshCloudResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCloudResource not implemented")
+}
 func (UnimplementedStackUpdateCommandControllerServer) mustEmbedUnimplementedStackUpdateCommandControllerServer() {
 }
 func (UnimplementedStackUpdateCommandControllerServer) testEmbeddedByValue() {}
@@ -113,6 +173,60 @@ func _StackUpdateCommandController_DeployCloudResource_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StackUpdateCommandController_DestroyCloudResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyCloudResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StackUpdateCommandControllerServer).DestroyCloudResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StackUpdateCommandController_DestroyCloudResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StackUpdateCommandControllerServer).DestroyCloudResource(ctx, req.(*DestroyCloudResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StackUpdateCommandController_PreviewCloudResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCloudResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StackUpdateCommandControllerServer).PreviewCloudResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StackUpdateCommandController_PreviewCloudResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StackUpdateCommandControllerServer).PreviewCloudResource(ctx, req.(*PreviewCloudResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StackUpdateCommandController_RefreshCloudResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCloudResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StackUpdateCommandControllerServer).RefreshCloudResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StackUpdateCommandController_RefreshCloudResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StackUpdateCommandControllerServer).RefreshCloudResource(ctx, req.(*RefreshCloudResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StackUpdateCommandController_ServiceDesc is the grpc.ServiceDesc for StackUpdateCommandController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -124,6 +238,18 @@ var StackUpdateCommandController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployCloudResource",
 			Handler:    _StackUpdateCommandController_DeployCloudResource_Handler,
 		},
+		{
+			MethodName: "DestroyCloudResource",
+			Handler:    _StackUpdateCommandController_DestroyCloudResource_Handler,
+		},
+		{
+			MethodName: "PreviewCloudResource",
+			Handler:    _StackUpdateCommandController_PreviewCloudResource_Handler,
+		},
+		{
+			MethodName: "RefreshCloudResource",
+			Handler:    _StackUpdateCommandController_RefreshCloudResource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "org/openmcf/app/stackupdate/v1/command.proto",
@@ -28,8 +28,12 @@ type DeployCloudResourceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the cloud resource to deploy.
 	CloudResourceId string `protobuf:"bytes,1,opt,name=cloud_resource_id,json=cloudResourceId,proto3" json:"cloud_resource_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// ID of the credential to deploy with. When empty, the credential is selected by the
+	// openmcf.org/credential manifest label, then by the default credential of metadata.env,
+	// then as the only credential of the provider.
+	CredentialId  string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeployCloudResourceRequest) Reset() {
@@ -69,6 +73,13 @@ func (x *DeployCloudResourceRequest) GetCloudResourceId() string {
 	return ""
 }
 
+func (x *DeployCloudResourceRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
 // Response message containing the created stack-update.
 type DeployCloudResourceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -115,6 +126,310 @@ func (x *DeployCloudResourceResponse) GetStackUpdate() *StackUpdate {
 	return nil
 }
 
+// Request message for destroying the infrastructure of a cloud resource.
+type DestroyCloudResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the cloud resource to destroy.
+	// The cloud resource record is kept; delete it with CloudResourceCommandController.Delete.
+	CloudResourceId string `protobuf:"bytes,1,opt,name=cloud_resource_id,json=cloudResourceId,proto3" json:"cloud_resource_id,omitempty"`
+	// ID of the credential to use. Selected the same way as for a deploy when empty.
+	CredentialId  string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyCloudResourceRequest) Reset() {
+	*x = DestroyCloudResourceRequest{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyCloudResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyCloudResourceRequest) ProtoMessage() {}
+
+func (x *DestroyCloudResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyCloudResourceRequest.ProtoReflect.Descriptor instead.
+func (*DestroyCloudResourceRequest) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DestroyCloudResourceRequest) GetCloudResourceId() string {
+	if x != nil {
+		return x.CloudResourceId
+	}
+	return ""
+}
+
+func (x *DestroyCloudResourceRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+// Response message containing the created stack-update.
+type DestroyCloudResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created stack-update.
+	StackUpdate   *StackUpdate `protobuf:"bytes,1,opt,name=stack_update,json=stackUpdate,proto3" json:"stack_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyCloudResourceResponse) Reset() {
+	*x = DestroyCloudResourceResponse{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyCloudResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyCloudResourceResponse) ProtoMessage() {}
+
+func (x *DestroyCloudResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyCloudResourceResponse.ProtoReflect.Descriptor instead.
+func (*DestroyCloudResourceResponse) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DestroyCloudResourceResponse) GetStackUpdate() *StackUpdate {
+	if x != nil {
+		return x.StackUpdate
+	}
+	return nil
+}
+
+// Request message for previewing the changes a deploy of a cloud resource would make.
+type PreviewCloudResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the cloud resource to preview.
+	CloudResourceId string `protobuf:"bytes,1,opt,name=cloud_resource_id,json=cloudResourceId,proto3" json:"cloud_resource_id,omitempty"`
+	// ID of the credential to use. Selected the same way as for a deploy when empty.
+	CredentialId  string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCloudResourceRequest) Reset() {
+	*x = PreviewCloudResourceRequest{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCloudResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCloudResourceRequest) ProtoMessage() {}
+
+func (x *PreviewCloudResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCloudResourceRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCloudResourceRequest) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PreviewCloudResourceRequest) GetCloudResourceId() string {
+	if x != nil {
+		return x.CloudResourceId
+	}
+	return ""
+}
+
+func (x *PreviewCloudResourceRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+// Response message containing the created stack-update.
+type PreviewCloudResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created stack-update.
+	StackUpdate   *StackUpdate `protobuf:"bytes,1,opt,name=stack_update,json=stackUpdate,proto3" json:"stack_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCloudResourceResponse) Reset() {
+	*x = PreviewCloudResourceResponse{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCloudResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCloudResourceResponse) ProtoMessage() {}
+
+func (x *PreviewCloudResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCloudResourceResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCloudResourceResponse) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreviewCloudResourceResponse) GetStackUpdate() *StackUpdate {
+	if x != nil {
+		return x.StackUpdate
+	}
+	return nil
+}
+
+// Request message for refreshing the state of a cloud resource from the real infrastructure.
+type RefreshCloudResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the cloud resource to refresh.
+	CloudResourceId string `protobuf:"bytes,1,opt,name=cloud_resource_id,json=cloudResourceId,proto3" json:"cloud_resource_id,omitempty"`
+	// ID of the credential to use. Selected the same way as for a deploy when empty.
+	CredentialId  string `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCloudResourceRequest) Reset() {
+	*x = RefreshCloudResourceRequest{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCloudResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCloudResourceRequest) ProtoMessage() {}
+
+func (x *RefreshCloudResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCloudResourceRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCloudResourceRequest) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefreshCloudResourceRequest) GetCloudResourceId() string {
+	if x != nil {
+		return x.CloudResourceId
+	}
+	return ""
+}
+
+func (x *RefreshCloudResourceRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+// Response message containing the created stack-update.
+type RefreshCloudResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created stack-update.
+	StackUpdate   *StackUpdate `protobuf:"bytes,1,opt,name=stack_update,json=stackUpdate,proto3" json:"stack_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCloudResourceResponse) Reset() {
+	*x = RefreshCloudResourceResponse{}
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCloudResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCloudResourceResponse) ProtoMessage() {}
+
+func (x *RefreshCloudResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCloudResourceResponse.ProtoReflect.Descriptor instead.
+func (*RefreshCloudResourceResponse) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RefreshCloudResourceResponse) GetStackUpdate() *StackUpdate {
+	if x != nil {
+		return x.StackUpdate
+	}
+	return nil
+}
+
 // Request message for retrieving a stack-update by ID.
 type GetStackUpdateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -126,7 +441,7 @@ type GetStackUpdateRequest struct {
 
 func (x *GetStackUpdateRequest) Reset() {
 	*x = GetStackUpdateRequest{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[2]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +453,7 @@ func (x *GetStackUpdateRequest) String() string {
 func (*GetStackUpdateRequest) ProtoMessage() {}
 
 func (x *GetStackUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[2]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +466,7 @@ func (x *GetStackUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStackUpdateRequest.ProtoReflect.Descriptor instead.
 func (*GetStackUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{2}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetStackUpdateRequest) GetId() string {
@@ -172,7 +487,7 @@ type GetStackUpdateResponse struct {
 
 func (x *GetStackUpdateResponse) Reset() {
 	*x = GetStackUpdateResponse{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[3]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +499,7 @@ func (x *GetStackUpdateResponse) String() string {
 func (*GetStackUpdateResponse) ProtoMessage() {}
 
 func (x *GetStackUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[3]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +512,7 @@ func (x *GetStackUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStackUpdateResponse.ProtoReflect.Descriptor instead.
 func (*GetStackUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{3}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetStackUpdateResponse) GetStackUpdate() *StackUpdate {
@@ -222,7 +537,7 @@ type ListStackUpdatesRequest struct {
 
 func (x *ListStackUpdatesRequest) Reset() {
 	*x = ListStackUpdatesRequest{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[4]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +549,7 @@ func (x *ListStackUpdatesRequest) String() string {
 func (*ListStackUpdatesRequest) ProtoMessage() {}
 
 func (x *ListStackUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[4]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +562,7 @@ func (x *ListStackUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStackUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*ListStackUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{4}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListStackUpdatesRequest) GetCloudResourceId() string {
@@ -284,7 +599,7 @@ type ListStackUpdatesResponse struct {
 
 func (x *ListStackUpdatesResponse) Reset() {
 	*x = ListStackUpdatesResponse{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[5]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +611,7 @@ func (x *ListStackUpdatesResponse) String() string {
 func (*ListStackUpdatesResponse) ProtoMessage() {}
 
 func (x *ListStackUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[5]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +624,7 @@ func (x *ListStackUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStackUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*ListStackUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{5}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListStackUpdatesResponse) GetStackUpdates() []*StackUpdate {
@@ -339,7 +654,7 @@ type StreamStackUpdateOutputRequest struct {
 
 func (x *StreamStackUpdateOutputRequest) Reset() {
 	*x = StreamStackUpdateOutputRequest{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[6]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +666,7 @@ func (x *StreamStackUpdateOutputRequest) String() string {
 func (*StreamStackUpdateOutputRequest) ProtoMessage() {}
 
 func (x *StreamStackUpdateOutputRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[6]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +679,7 @@ func (x *StreamStackUpdateOutputRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamStackUpdateOutputRequest.ProtoReflect.Descriptor instead.
 func (*StreamStackUpdateOutputRequest) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{6}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StreamStackUpdateOutputRequest) GetJobId() string {
@@ -400,7 +715,7 @@ type StreamStackUpdateOutputResponse struct {
 
 func (x *StreamStackUpdateOutputResponse) Reset() {
 	*x = StreamStackUpdateOutputResponse{}
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[7]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -412,7 +727,7 @@ func (x *StreamStackUpdateOutputResponse) String() string {
 func (*StreamStackUpdateOutputResponse) ProtoMessage() {}
 
 func (x *StreamStackUpdateOutputResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[7]
+	mi := &file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +740,7 @@ func (x *StreamStackUpdateOutputResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamStackUpdateOutputResponse.ProtoReflect.Descriptor instead.
 func (*StreamStackUpdateOutputResponse) Descriptor() ([]byte, []int) {
-	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{7}
+	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StreamStackUpdateOutputResponse) GetSequenceNum() int32 {
@@ -467,10 +782,26 @@ var File_org_openmcf_app_stackupdate_v1_io_proto protoreflect.FileDescriptor
 
 const file_org_openmcf_app_stackupdate_v1_io_proto_rawDesc = "" +
 	"\n" +
-	"'org/openmcf/app/stackupdate/v1/io.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a'org/openmcf/app/commons/page_info.proto\x1a(org/openmcf/app/stackupdate/v1/api.proto\"H\n" +
+	"'org/openmcf/app/stackupdate/v1/io.proto\x12\x1eorg.openmcf.app.stackupdate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a'org/openmcf/app/commons/page_info.proto\x1a(org/openmcf/app/stackupdate/v1/api.proto\"m\n" +
 	"\x1aDeployCloudResourceRequest\x12*\n" +
-	"\x11cloud_resource_id\x18\x01 \x01(\tR\x0fcloudResourceId\"m\n" +
+	"\x11cloud_resource_id\x18\x01 \x01(\tR\x0fcloudResourceId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"m\n" +
 	"\x1bDeployCloudResourceResponse\x12N\n" +
+	"\fstack_update\x18\x01 \x01(\v2+.org.openmcf.app.stackupdate.v1.StackUpdateR\vstackUpdate\"n\n" +
+	"\x1bDestroyCloudResourceRequest\x12*\n" +
+	"\x11cloud_resource_id\x18\x01 \x01(\tR\x0fcloudResourceId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"n\n" +
+	"\x1cDestroyCloudResourceResponse\x12N\n" +
+	"\fstack_update\x18\x01 \x01(\v2+.org.openmcf.app.stackupdate.v1.StackUpdateR\vstackUpdate\"n\n" +
+	"\x1bPreviewCloudResourceRequest\x12*\n" +
+	"\x11cloud_resource_id\x18\x01 \x01(\tR\x0fcloudResourceId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"n\n" +
+	"\x1cPreviewCloudResourceResponse\x12N\n" +
+	"\fstack_update\x18\x01 \x01(\v2+.org.openmcf.app.stackupdate.v1.StackUpdateR\vstackUpdate\"n\n" +
+	"\x1bRefreshCloudResourceRequest\x12*\n" +
+	"\x11cloud_resource_id\x18\x01 \x01(\tR\x0fcloudResourceId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"n\n" +
+	"\x1cRefreshCloudResourceResponse\x12N\n" +
 	"\fstack_update\x18\x01 \x01(\v2+.org.openmcf.app.stackupdate.v1.StackUpdateR\vstackUpdate\"'\n" +
 	"\x15GetStackUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"h\n" +
@@ -508,31 +839,40 @@ func file_org_openmcf_app_stackupdate_v1_io_proto_rawDescGZIP() []byte {
 	return file_org_openmcf_app_stackupdate_v1_io_proto_rawDescData
 }
 
-var file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_org_openmcf_app_stackupdate_v1_io_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_org_openmcf_app_stackupdate_v1_io_proto_goTypes = []any{
 	(*DeployCloudResourceRequest)(nil),      // 0: org.openmcf.app.stackupdate.v1.DeployCloudResourceRequest
 	(*DeployCloudResourceResponse)(nil),     // 1: org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse
-	(*GetStackUpdateRequest)(nil),           // 2: org.openmcf.app.stackupdate.v1.GetStackUpdateRequest
-	(*GetStackUpdateResponse)(nil),          // 3: org.openmcf.app.stackupdate.v1.GetStackUpdateResponse
-	(*ListStackUpdatesRequest)(nil),         // 4: org.openmcf.app.stackupdate.v1.ListStackUpdatesRequest
-	(*ListStackUpdatesResponse)(nil),        // 5: org.openmcf.app.stackupdate.v1.ListStackUpdatesResponse
-	(*StreamStackUpdateOutputRequest)(nil),  // 6: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputRequest
-	(*StreamStackUpdateOutputResponse)(nil), // 7: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputResponse
-	(*StackUpdate)(nil),                     // 8: org.openmcf.app.stackupdate.v1.StackUpdate
-	(*commons.PageInfo)(nil),                // 9: org.openmcf.app.commons.PageInfo
-	(*timestamppb.Timestamp)(nil),           // 10: google.protobuf.Timestamp
+	(*DestroyCloudResourceRequest)(nil),     // 2: org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest
+	(*DestroyCloudResourceResponse)(nil),    // 3: org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse
+	(*PreviewCloudResourceRequest)(nil),     // 4: org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest
+	(*PreviewCloudResourceResponse)(nil),    // 5: org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse
+	(*RefreshCloudResourceRequest)(nil),     // 6: org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest
+	(*RefreshCloudResourceResponse)(nil),    // 7: org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse
+	(*GetStackUpdateRequest)(nil),           // 8: org.openmcf.app.stackupdate.v1.GetStackUpdateRequest
+	(*GetStackUpdateResponse)(nil),          // 9: org.openmcf.app.stackupdate.v1.GetStackUpdateResponse
+	(*ListStackUpdatesRequest)(nil),         // 10: org.openmcf.app.stackupdate.v1.ListStackUpdatesRequest
+	(*ListStackUpdatesResponse)(nil),        // 11: org.openmcf.app.stackupdate.v1.ListStackUpdatesResponse
+	(*StreamStackUpdateOutputRequest)(nil),  // 12: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputRequest
+	(*StreamStackUpdateOutputResponse)(nil), // 13: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputResponse
+	(*StackUpdate)(nil),                     // 14: org.openmcf.app.stackupdate.v1.StackUpdate
+	(*commons.PageInfo)(nil),                // 15: org.openmcf.app.commons.PageInfo
+	(*timestamppb.Timestamp)(nil),           // 16: google.protobuf.Timestamp
 }
 var file_org_openmcf_app_stackupdate_v1_io_proto_depIdxs = []int32{
-	8,  // 0: org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
-	8,  // 1: org.openmcf.app.stackupdate.v1.GetStackUpdateResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
-	9,  // 2: org.openmcf.app.stackupdate.v1.ListStackUpdatesRequest.page_info:type_name -> org.openmcf.app.commons.PageInfo
-	8,  // 3: org.openmcf.app.stackupdate.v1.ListStackUpdatesResponse.stack_updates:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
-	10, // 4: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputResponse.timestamp:type_name -> google.protobuf.Timestamp
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	14, // 0: org.openmcf.app.stackupdate.v1.DeployCloudResourceResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	14, // 1: org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	14, // 2: org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	14, // 3: org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	14, // 4: org.openmcf.app.stackupdate.v1.GetStackUpdateResponse.stack_update:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	15, // 5: org.openmcf.app.stackupdate.v1.ListStackUpdatesRequest.page_info:type_name -> org.openmcf.app.commons.PageInfo
+	14, // 6: org.openmcf.app.stackupdate.v1.ListStackUpdatesResponse.stack_updates:type_name -> org.openmcf.app.stackupdate.v1.StackUpdate
+	16, // 7: org.openmcf.app.stackupdate.v1.StreamStackUpdateOutputResponse.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_org_openmcf_app_stackupdate_v1_io_proto_init() }
@@ -547,7 +887,7 @@ func file_org_openmcf_app_stackupdate_v1_io_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_org_openmcf_app_stackupdate_v1_io_proto_rawDesc), len(file_org_openmcf_app_stackupdate_v1_io_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -37,14 +37,32 @@ const (
 	// StackUpdateCommandControllerDeployCloudResourceProcedure is the fully-qualified name of the
 	// StackUpdateCommandController's DeployCloudResource RPC.
 	StackUpdateCommandControllerDeployCloudResourceProcedure = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/DeployCloudResource"
+	// StackUpdateCommandControllerDestroyCloudResourceProcedure is the fully-qualified name of the
+	// StackUpdateCommandController's DestroyCloudResource RPC.
+	StackUpdateCommandControllerDestroyCloudResourceProcedure = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/DestroyCloudResource"
+	// StackUpdateCommandControllerPreviewCloudResourceProcedure is the fully-qualified name of the
+	// StackUpdateCommandController's PreviewCloudResource RPC.
+	StackUpdateCommandControllerPreviewCloudResourceProcedure = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/PreviewCloudResource"
+	// StackUpdateCommandControllerRefreshCloudResourceProcedure is the fully-qualified name of the
+	// StackUpdateCommandController's RefreshCloudResource RPC.
+	StackUpdateCommandControllerRefreshCloudResourceProcedure = "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/RefreshCloudResource"
 )
 
 // StackUpdateCommandControllerClient is a client for the
 // org.openmcf.app.stackupdate.v1.StackUpdateCommandController service.
 type StackUpdateCommandControllerClient interface {
-	// DeployCloudResource deploys a cloud resource using Pulumi.
-	// Takes a cloud resource ID, fetches the manifest, executes pulumi up, and stores the result in stackupdates table.
+	// DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+	// Takes a cloud resource ID, fetches the manifest, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
 	DeployCloudResource(context.Context, *connect.Request[v1.DeployCloudResourceRequest]) (*connect.Response[v1.DeployCloudResourceResponse], error)
+	// DestroyCloudResource destroys the infrastructure of a cloud resource.
+	// Runs pulumi destroy or tofu/terraform destroy and keeps the cloud resource record.
+	DestroyCloudResource(context.Context, *connect.Request[v1.DestroyCloudResourceRequest]) (*connect.Response[v1.DestroyCloudResourceResponse], error)
+	// PreviewCloudResource previews the changes a deploy would make without applying them.
+	// Runs pulumi preview or tofu/terraform plan.
+	PreviewCloudResource(context.Context, *connect.Request[v1.PreviewCloudResourceRequest]) (*connect.Response[v1.PreviewCloudResourceResponse], error)
+	// RefreshCloudResource syncs the stack state with the real infrastructure.
+	// Runs pulumi refresh or tofu/terraform refresh.
+	RefreshCloudResource(context.Context, *connect.Request[v1.RefreshCloudResourceRequest]) (*connect.Response[v1.RefreshCloudResourceResponse], error)
 }
 
 // NewStackUpdateCommandControllerClient constructs a client for the
@@ -65,12 +83,33 @@ func NewStackUpdateCommandControllerClient(httpClient connect.HTTPClient, baseUR
 			connect.WithSchema(stackUpdateCommandControllerMethods.ByName("DeployCloudResource")),
 			connect.WithClientOptions(opts...),
 		),
+		destroyCloudResource: connect.NewClient[v1.DestroyCloudResourceRequest, v1.DestroyCloudResourceResponse](
+			httpClient,
+			baseURL+StackUpdateCommandControllerDestroyCloudResourceProcedure,
+			connect.WithSchema(stackUpdateCommandControllerMethods.ByName("DestroyCloudResource")),
+			connect.WithClientOptions(opts...),
+		),
+		previewCloudResource: connect.NewClient[v1.PreviewCloudResourceRequest, v1.PreviewCloudResourceResponse](
+			httpClient,
+			baseURL+StackUpdateCommandControllerPreviewCloudResourceProcedure,
+			connect.WithSchema(stackUpdateCommandControllerMethods.ByName("PreviewCloudResource")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshCloudResource: connect.NewClient[v1.RefreshCloudResourceRequest, v1.RefreshCloudResourceResponse](
+			httpClient,
+			baseURL+StackUpdateCommandControllerRefreshCloudResourceProcedure,
+			connect.WithSchema(stackUpdateCommandControllerMethods.ByName("RefreshCloudResource")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // stackUpdateCommandControllerClient implements StackUpdateCommandControllerClient.
 type stackUpdateCommandControllerClient struct {
-	deployCloudResource *connect.Client[v1.DeployCloudResourceRequest, v1.DeployCloudResourceResponse]
+	deployCloudResource  *connect.Client[v1.DeployCloudResourceRequest, v1.DeployCloudResourceResponse]
+	destroyCloudResource *connect.Client[v1.DestroyCloudResourceRequest, v1.DestroyCloudResourceResponse]
+	previewCloudResource *connect.Client[v1.PreviewCloudResourceRequest, v1.PreviewCloudResourceResponse]
+	refreshCloudResource *connect.Client[v1.RefreshCloudResourceRequest, v1.RefreshCloudResourceResponse]
 }
 
 // DeployCloudResource calls
@@ -79,12 +118,39 @@ func (c *stackUpdateCommandControllerClient) DeployCloudResource(ctx context.Con
 	return c.deployCloudResource.CallUnary(ctx, req)
 }
 
+// DestroyCloudResource calls
+// org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DestroyCloudResource.
+func (c *stackUpdateCommandControllerClient) DestroyCloudResource(ctx context.Context, req *connect.Request[v1.DestroyCloudResourceRequest]) (*connect.Response[v1.DestroyCloudResourceResponse], error) {
+	return c.destroyCloudResource.CallUnary(ctx, req)
+}
+
+// PreviewCloudResource calls
+// org.openmcf.app.stackupdate.v1.StackUpdateCommandController.PreviewCloudResource.
+func (c *stackUpdateCommandControllerClient) PreviewCloudResource(ctx context.Context, req *connect.Request[v1.PreviewCloudResourceRequest]) (*connect.Response[v1.PreviewCloudResourceResponse], error) {
+	return c.previewCloudResource.CallUnary(ctx, req)
+}
+
+// RefreshCloudResource calls
+// org.openmcf.app.stackupdate.v1.StackUpdateCommandController.RefreshCloudResource.
+func (c *stackUpdateCommandControllerClient) RefreshCloudResource(ctx context.Context, req *connect.Request[v1.RefreshCloudResourceRequest]) (*connect.Response[v1.RefreshCloudResourceResponse], error) {
+	return c.refreshCloudResource.CallUnary(ctx, req)
+}
+
 // StackUpdateCommandControllerHandler is an implementation of the
 // org.openmcf.app.stackupdate.v1.StackUpdateCommandController service.
 type StackUpdateCommandControllerHandler interface {
-	// DeployCloudResource deploys a cloud resource using Pulumi.
-	// Takes a cloud resource ID, fetches the manifest, executes pulumi up, and stores the result in stackupdates table.
+	// DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+	// Takes a cloud resource ID, fetches the manifest, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
 	DeployCloudResource(context.Context, *connect.Request[v1.DeployCloudResourceRequest]) (*connect.Response[v1.DeployCloudResourceResponse], error)
+	// DestroyCloudResource destroys the infrastructure of a cloud resource.
+	// Runs pulumi destroy or tofu/terraform destroy and keeps the cloud resource record.
+	DestroyCloudResource(context.Context, *connect.Request[v1.DestroyCloudResourceRequest]) (*connect.Response[v1.DestroyCloudResourceResponse], error)
+	// PreviewCloudResource previews the changes a deploy would make without applying them.
+	// Runs pulumi preview or tofu/terraform plan.
+	PreviewCloudResource(context.Context, *connect.Request[v1.PreviewCloudResourceRequest]) (*connect.Response[v1.PreviewCloudResourceResponse], error)
+	// RefreshCloudResource syncs the stack state with the real infrastructure.
+	// Runs pulumi refresh or tofu/terraform refresh.
+	RefreshCloudResource(context.Context, *connect.Request[v1.RefreshCloudResourceRequest]) (*connect.Response[v1.RefreshCloudResourceResponse], error)
 }
 
 // NewStackUpdateCommandControllerHandler builds an HTTP handler from the service implementation. It
@@ -100,10 +166,34 @@ func NewStackUpdateCommandControllerHandler(svc StackUpdateCommandControllerHand
 		connect.WithSchema(stackUpdateCommandControllerMethods.ByName("DeployCloudResource")),
 		connect.WithHandlerOptions(opts...),
 	)
+	stackUpdateCommandControllerDestroyCloudResourceHandler := connect.NewUnaryHandler(
+		StackUpdateCommandControllerDestroyCloudResourceProcedure,
+		svc.DestroyCloudResource,
+		connect.WithSchema(stackUpdateCommandControllerMethods.ByName("DestroyCloudResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stackUpdateCommandControllerPreviewCloudResourceHandler := connect.NewUnaryHandler(
+		StackUpdateCommandControllerPreviewCloudResourceProcedure,
+		svc.PreviewCloudResource,
+		connect.WithSchema(stackUpdateCommandControllerMethods.ByName("PreviewCloudResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stackUpdateCommandControllerRefreshCloudResourceHandler := connect.NewUnaryHandler(
+		StackUpdateCommandControllerRefreshCloudResourceProcedure,
+		svc.RefreshCloudResource,
+		connect.WithSchema(stackUpdateCommandControllerMethods.ByName("RefreshCloudResource")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/org.openmcf.app.stackupdate.v1.StackUpdateCommandController/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StackUpdateCommandControllerDeployCloudResourceProcedure:
 			stackUpdateCommandControllerDeployCloudResourceHandler.ServeHTTP(w, r)
+		case StackUpdateCommandControllerDestroyCloudResourceProcedure:
+			stackUpdateCommandControllerDestroyCloudResourceHandler.ServeHTTP(w, r)
+		case StackUpdateCommandControllerPreviewCloudResourceProcedure:
+			stackUpdateCommandControllerPreviewCloudResourceHandler.ServeHTTP(w, r)
+		case StackUpdateCommandControllerRefreshCloudResourceProcedure:
+			stackUpdateCommandControllerRefreshCloudResourceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -116,3 +206,15 @@ type UnimplementedStackUpdateCommandControllerHandler struct{}
 func (UnimplementedStackUpdateCommandControllerHandler) DeployCloudResource(context.Context, *connect.Request[v1.DeployCloudResourceRequest]) (*connect.Response[v1.DeployCloudResourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DeployCloudResource is not implemented"))
 }
+
+func (UnimplementedStackUpdateCommandControllerHandler) DestroyCloudResource(context.Context, *connect.Request[v1.DestroyCloudResourceRequest]) (*connect.Response[v1.DestroyCloudResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DestroyCloudResource is not implemented"))
+}
+
+func (UnimplementedStackUpdateCommandControllerHandler) PreviewCloudResource(context.Context, *connect.Request[v1.PreviewCloudResourceRequest]) (*connect.Response[v1.PreviewCloudResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.openmcf.app.stackupdate.v1.StackUpdateCommandController.PreviewCloudResource is not implemented"))
+}
+
+func (UnimplementedStackUpdateCommandControllerHandler) RefreshCloudResource(context.Context, *connect.Request[v1.RefreshCloudResourceRequest]) (*connect.Response[v1.RefreshCloudResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("org.openmcf.app.stackupdate.v1.StackUpdateCommandController.RefreshCloudResource is not implemented"))
+}
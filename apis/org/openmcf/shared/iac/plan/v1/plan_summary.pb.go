@@ -0,0 +1,419 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: org/openmcf/shared/iac/plan/v1/plan_summary.proto
+
+package planv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PlanAction is the change a plan makes to a single resource.
+type PlanAction int32
+
+const (
+	PlanAction_plan_action_unspecified PlanAction = 0
+	// The resource will be created.
+	PlanAction_create PlanAction = 1
+	// The resource will be updated in place.
+	PlanAction_update PlanAction = 2
+	// The resource will be deleted and created again.
+	PlanAction_replace PlanAction = 3
+	// The resource will be deleted.
+	PlanAction_delete PlanAction = 4
+)
+
+// Enum value maps for PlanAction.
+var (
+	PlanAction_name = map[int32]string{
+		0: "plan_action_unspecified",
+		1: "create",
+		2: "update",
+		3: "replace",
+		4: "delete",
+	}
+	PlanAction_value = map[string]int32{
+		"plan_action_unspecified": 0,
+		"create":                  1,
+		"update":                  2,
+		"replace":                 3,
+		"delete":                  4,
+	}
+)
+
+func (x PlanAction) Enum() *PlanAction {
+	p := new(PlanAction)
+	*p = x
+	return p
+}
+
+func (x PlanAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanAction) Type() protoreflect.EnumType {
+	return &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_enumTypes[0]
+}
+
+func (x PlanAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanAction.Descriptor instead.
+func (PlanAction) EnumDescriptor() ([]byte, []int) {
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescGZIP(), []int{0}
+}
+
+// PlanSummary is the provisioner-independent summary of a plan or preview.
+// It is built from `pulumi preview --json` or from `tofu show -json` / `terraform show -json`
+// of a saved plan, so plans read the same regardless of the provisioner.
+type PlanSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the cloud resource the plan was made for, e.g. "AwsVpc/prod/main".
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// IaC provisioner that produced the plan: pulumi, tofu or terraform.
+	Provisioner string `protobuf:"bytes,2,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	// Resources the plan changes, in the order the provisioner reported them.
+	// Resources left unchanged are omitted.
+	Changes       []*PlanResourceChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanSummary) Reset() {
+	*x = PlanSummary{}
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanSummary) ProtoMessage() {}
+
+func (x *PlanSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanSummary.ProtoReflect.Descriptor instead.
+func (*PlanSummary) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlanSummary) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PlanSummary) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
+	}
+	return ""
+}
+
+func (x *PlanSummary) GetChanges() []*PlanResourceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// PlanResourceChange is the planned change of a single IaC resource, e.g. one aws_vpc of a Tofu module.
+type PlanResourceChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pulumi URN or Tofu/Terraform resource address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Provider resource type, e.g. "aws:ec2/vpc:Vpc" or "aws_vpc".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Logical name of the resource within the stack or module.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Change the plan makes to the resource.
+	Action PlanAction `protobuf:"varint,4,opt,name=action,proto3,enum=org.openmcf.shared.iac.plan.v1.PlanAction" json:"action,omitempty"`
+	// Properties changed by an update or replacement.
+	// Creates and deletes list no properties.
+	Properties    []*PlanPropertyChange `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResourceChange) Reset() {
+	*x = PlanResourceChange{}
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResourceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResourceChange) ProtoMessage() {}
+
+func (x *PlanResourceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResourceChange.ProtoReflect.Descriptor instead.
+func (*PlanResourceChange) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanResourceChange) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PlanResourceChange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlanResourceChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanResourceChange) GetAction() PlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return PlanAction_plan_action_unspecified
+}
+
+func (x *PlanResourceChange) GetProperties() []*PlanPropertyChange {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// PlanPropertyChange is a single property whose value the plan changes.
+type PlanPropertyChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Property path, e.g. "tags.env" or "ingress[0].cidr_blocks".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Current value, unset if the property is not set.
+	Before *structpb.Value `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// Planned value, unset if the property will be removed or is not known until apply.
+	After *structpb.Value `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	// Values are redacted because the provisioner marks them as sensitive.
+	Sensitive bool `protobuf:"varint,4,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// The planned value is computed by the provider during apply.
+	KnownAfterApply bool `protobuf:"varint,5,opt,name=known_after_apply,json=knownAfterApply,proto3" json:"known_after_apply,omitempty"`
+	// Changing the property forces the resource to be replaced.
+	ForcesReplacement bool `protobuf:"varint,6,opt,name=forces_replacement,json=forcesReplacement,proto3" json:"forces_replacement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlanPropertyChange) Reset() {
+	*x = PlanPropertyChange{}
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPropertyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPropertyChange) ProtoMessage() {}
+
+func (x *PlanPropertyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPropertyChange.ProtoReflect.Descriptor instead.
+func (*PlanPropertyChange) Descriptor() ([]byte, []int) {
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlanPropertyChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PlanPropertyChange) GetBefore() *structpb.Value {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PlanPropertyChange) GetAfter() *structpb.Value {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PlanPropertyChange) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *PlanPropertyChange) GetKnownAfterApply() bool {
+	if x != nil {
+		return x.KnownAfterApply
+	}
+	return false
+}
+
+func (x *PlanPropertyChange) GetForcesReplacement() bool {
+	if x != nil {
+		return x.ForcesReplacement
+	}
+	return false
+}
+
+var File_org_openmcf_shared_iac_plan_v1_plan_summary_proto protoreflect.FileDescriptor
+
+const file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDesc = "" +
+	"\n" +
+	"1org/openmcf/shared/iac/plan/v1/plan_summary.proto\x12\x1eorg.openmcf.shared.iac.plan.v1\x1a\x1cgoogle/protobuf/struct.proto\"\x99\x01\n" +
+	"\vPlanSummary\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12 \n" +
+	"\vprovisioner\x18\x02 \x01(\tR\vprovisioner\x12L\n" +
+	"\achanges\x18\x03 \x03(\v22.org.openmcf.shared.iac.plan.v1.PlanResourceChangeR\achanges\"\xee\x01\n" +
+	"\x12PlanResourceChange\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12B\n" +
+	"\x06action\x18\x04 \x01(\x0e2*.org.openmcf.shared.iac.plan.v1.PlanActionR\x06action\x12R\n" +
+	"\n" +
+	"properties\x18\x05 \x03(\v22.org.openmcf.shared.iac.plan.v1.PlanPropertyChangeR\n" +
+	"properties\"\xff\x01\n" +
+	"\x12PlanPropertyChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12.\n" +
+	"\x06before\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06before\x12,\n" +
+	"\x05after\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05after\x12\x1c\n" +
+	"\tsensitive\x18\x04 \x01(\bR\tsensitive\x12*\n" +
+	"\x11known_after_apply\x18\x05 \x01(\bR\x0fknownAfterApply\x12-\n" +
+	"\x12forces_replacement\x18\x06 \x01(\bR\x11forcesReplacement*Z\n" +
+	"\n" +
+	"PlanAction\x12\x1b\n" +
+	"\x17plan_action_unspecified\x10\x00\x12\n" +
+	"\n" +
+	"\x06create\x10\x01\x12\n" +
+	"\n" +
+	"\x06update\x10\x02\x12\v\n" +
+	"\areplace\x10\x03\x12\n" +
+	"\n" +
+	"\x06delete\x10\x04B\x9e\x02\n" +
+	"\"com.org.openmcf.shared.iac.plan.v1B\x10PlanSummaryProtoP\x01ZGgithub.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1;planv1\xa2\x02\x05OOSIP\xaa\x02\x1eOrg.Openmcf.Shared.Iac.Plan.V1\xca\x02\x1eOrg\\Openmcf\\Shared\\Iac\\Plan\\V1\xe2\x02*Org\\Openmcf\\Shared\\Iac\\Plan\\V1\\GPBMetadata\xea\x02#Org::Openmcf::Shared::Iac::Plan::V1b\x06proto3"
+
+var (
+	file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescOnce sync.Once
+	file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescData []byte
+)
+
+func file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescGZIP() []byte {
+	file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescOnce.Do(func() {
+		file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDesc), len(file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDesc)))
+	})
+	return file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDescData
+}
+
+var file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_goTypes = []any{
+	(PlanAction)(0),            // 0: org.openmcf.shared.iac.plan.v1.PlanAction
+	(*PlanSummary)(nil),        // 1: org.openmcf.shared.iac.plan.v1.PlanSummary
+	(*PlanResourceChange)(nil), // 2: org.openmcf.shared.iac.plan.v1.PlanResourceChange
+	(*PlanPropertyChange)(nil), // 3: org.openmcf.shared.iac.plan.v1.PlanPropertyChange
+	(*structpb.Value)(nil),     // 4: google.protobuf.Value
+}
+var file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_depIdxs = []int32{
+	2, // 0: org.openmcf.shared.iac.plan.v1.PlanSummary.changes:type_name -> org.openmcf.shared.iac.plan.v1.PlanResourceChange
+	0, // 1: org.openmcf.shared.iac.plan.v1.PlanResourceChange.action:type_name -> org.openmcf.shared.iac.plan.v1.PlanAction
+	3, // 2: org.openmcf.shared.iac.plan.v1.PlanResourceChange.properties:type_name -> org.openmcf.shared.iac.plan.v1.PlanPropertyChange
+	4, // 3: org.openmcf.shared.iac.plan.v1.PlanPropertyChange.before:type_name -> google.protobuf.Value
+	4, // 4: org.openmcf.shared.iac.plan.v1.PlanPropertyChange.after:type_name -> google.protobuf.Value
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_init() }
+func file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_init() {
+	if File_org_openmcf_shared_iac_plan_v1_plan_summary_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDesc), len(file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_goTypes,
+		DependencyIndexes: file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_depIdxs,
+		EnumInfos:         file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_enumTypes,
+		MessageInfos:      file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_msgTypes,
+	}.Build()
+	File_org_openmcf_shared_iac_plan_v1_plan_summary_proto = out.File
+	file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_goTypes = nil
+	file_org_openmcf_shared_iac_plan_v1_plan_summary_proto_depIdxs = nil
+}
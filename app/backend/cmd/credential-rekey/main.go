@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/plantonhq/openmcf/app/backend/internal/database"
+	"github.com/plantonhq/openmcf/app/backend/internal/encryption"
+	"github.com/sirupsen/logrus"
+)
+
+// credential-rekey seals every stored credential secret with the key in CREDENTIAL_ENCRYPTION_KEY_FILE.
+// It encrypts secrets stored before encryption was enabled and, during a key rotation, re-encrypts secrets
+// sealed with a key listed in CREDENTIAL_ENCRYPTION_PREVIOUS_KEY_FILES.
+func main() {
+	mongoURI := flag.String("mongo-uri", getEnv("MONGODB_URI", "mongodb://localhost:27017"), "MongoDB connection URI")
+	mongoDatabase := flag.String("mongo-database", getEnv("MONGODB_DATABASE", "openmcf"), "MongoDB database name")
+	generateKey := flag.String("generate-key", "", "Write a new local encryption key to this file and exit")
+	flag.Parse()
+
+	logrus.SetFormatter(&logrus.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	if *generateKey != "" {
+		if err := encryption.GenerateLocalKeyFile(*generateKey); err != nil {
+			logrus.WithError(err).Fatal("Failed to generate encryption key")
+		}
+		logrus.WithField("file", *generateKey).Info("Encryption key written")
+		return
+	}
+
+	credentialSecrets, err := encryption.EnvelopeFromEnv()
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to load credential encryption key")
+	}
+	if credentialSecrets == nil {
+		logrus.Fatalf("%s must be set to re-key credentials", encryption.KeyFileEnv)
+	}
+
+	ctx := context.Background()
+	mongo, err := database.Connect(ctx, *mongoURI, *mongoDatabase)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to connect to MongoDB")
+	}
+	defer func() {
+		if err := mongo.Disconnect(context.Background()); err != nil {
+			logrus.WithError(err).Error("Failed to disconnect from MongoDB")
+		}
+	}()
+
+	rekeyed, err := database.NewCredentialRepository(mongo, credentialSecrets).Rekey(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("rekeyed", rekeyed).Fatal("Failed to re-key credentials")
+	}
+	logrus.WithFields(logrus.Fields{
+		"rekeyed": rekeyed,
+		"key_id":  credentialSecrets.PrimaryKeyID(),
+	}).Info("Credentials re-keyed")
+}
+
+// getEnv retrieves an environment variable or returns a default value.
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
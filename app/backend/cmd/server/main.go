@@ -8,7 +8,9 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
+	"github.com/plantonhq/openmcf/app/backend/internal/encryption"
 	"github.com/plantonhq/openmcf/app/backend/internal/server"
 	"github.com/sirupsen/logrus"
 )
@@ -44,10 +46,34 @@ func main() {
 		}
 	}()
 
+	// Load the key that seals credential secrets at rest
+	credentialSecrets, err := encryption.EnvelopeFromEnv()
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to load credential encryption key")
+	}
+	if credentialSecrets == nil {
+		logrus.Warnf("%s is not set, credential secrets are stored as plaintext", encryption.KeyFileEnv)
+	} else {
+		logrus.WithField("key_id", credentialSecrets.PrimaryKeyID()).Info("Credential encryption enabled")
+	}
+
+	// Load the tokens and OIDC issuer that authenticate API requests
+	authenticator, err := auth.AuthenticatorFromEnv()
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to load authentication config")
+	}
+	if authenticator == nil {
+		logrus.Warnf("%s is not set, the API accepts unauthenticated requests", auth.ConfigFileEnv)
+	} else {
+		logrus.Info("API authentication enabled")
+	}
+
 	// Create and start server
 	cfg := &server.Config{
-		Port:    *port,
-		MongoDB: mongo,
+		Port:              *port,
+		MongoDB:           mongo,
+		CredentialSecrets: credentialSecrets,
+		Authenticator:     authenticator,
 	}
 
 	srv := server.NewServer(cfg)
@@ -3,7 +3,9 @@ module github.com/plantonhq/openmcf/app/backend
 go 1.25.0
 
 require (
+	buf.build/go/protovalidate v1.0.0
 	connectrpc.com/connect v1.16.2
+	filippo.io/age v1.2.1
 	github.com/plantonhq/openmcf v0.2.245
 	github.com/sirupsen/logrus v1.9.3
 	go.mongodb.org/mongo-driver v1.16.1
@@ -14,7 +16,6 @@ require (
 
 require (
 	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.9-20250912141014-52f32327d4b0.1 // indirect
-	buf.build/go/protovalidate v1.0.0 // indirect
 	cel.dev/expr v0.24.0 // indirect
 	github.com/Masterminds/semver v1.4.2 // indirect
 	github.com/Masterminds/sprig v2.15.0+incompatible // indirect
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileEnv names the environment variable with the path of the authentication config file.
+const ConfigFileEnv = "AUTH_CONFIG_FILE"
+
+// defaultRolesClaim is the JWT claim read for grants when the config does not name one.
+const defaultRolesClaim = "openmcf_roles"
+
+// Config is the authentication config file.
+//
+//	tokens:
+//	  - name: ci
+//	    sha256: <hex sha256 of the token>
+//	    grants: ["deployer:acme:prod"]
+//	oidc:
+//	  issuer: https://login.example.com/
+//	  audience: openmcf
+//	  jwks_file: /etc/openmcf/jwks.json
+//	  roles_claim: openmcf_roles
+type Config struct {
+	Tokens []TokenConfig `yaml:"tokens"`
+	OIDC   *OIDCConfig   `yaml:"oidc"`
+}
+
+// TokenConfig is a static API token. Only the SHA-256 of the token is stored.
+type TokenConfig struct {
+	Name   string   `yaml:"name"`
+	SHA256 string   `yaml:"sha256"`
+	Grants []string `yaml:"grants"`
+}
+
+// OIDCConfig validates JWTs issued by an OIDC provider against the keys of a JWKS file.
+type OIDCConfig struct {
+	Issuer string `yaml:"issuer"`
+	// Audience, if set, must be one of the aud claim values.
+	Audience string `yaml:"audience"`
+	JWKSFile string `yaml:"jwks_file"`
+	// RolesClaim is the claim holding the grants of the subject. Defaults to openmcf_roles.
+	RolesClaim string `yaml:"roles_claim"`
+}
+
+// Authenticator resolves bearer tokens to principals.
+type Authenticator struct {
+	tokens     []staticToken
+	issuer     string
+	audience   string
+	keys       []verificationKey
+	rolesClaim string
+	now        func() time.Time
+}
+
+type staticToken struct {
+	hash      []byte
+	principal *Principal
+}
+
+// AuthenticatorFromEnv loads the config file named by AUTH_CONFIG_FILE.
+// It returns nil without an error when the variable is not set, which disables authentication.
+func AuthenticatorFromEnv() (*Authenticator, error) {
+	path := os.Getenv(ConfigFileEnv)
+	if path == "" {
+		return nil, nil
+	}
+	return LoadAuthenticator(path)
+}
+
+// LoadAuthenticator loads an authentication config file.
+func LoadAuthenticator(path string) (*Authenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth config: %w", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse auth config: %w", err)
+	}
+	return NewAuthenticator(&cfg)
+}
+
+// NewAuthenticator creates an authenticator from a config. At least one token or an OIDC issuer is required.
+func NewAuthenticator(cfg *Config) (*Authenticator, error) {
+	a := &Authenticator{now: time.Now}
+
+	for _, token := range cfg.Tokens {
+		if token.Name == "" {
+			return nil, fmt.Errorf("token name is required")
+		}
+		hash, err := hex.DecodeString(token.SHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("token %s: sha256 must be a hex encoded SHA-256 hash", token.Name)
+		}
+		grants, err := ParseGrants(token.Grants)
+		if err != nil {
+			return nil, fmt.Errorf("token %s: %w", token.Name, err)
+		}
+		a.tokens = append(a.tokens, staticToken{
+			hash:      hash,
+			principal: &Principal{Subject: token.Name, Grants: grants},
+		})
+	}
+
+	if cfg.OIDC != nil {
+		if cfg.OIDC.Issuer == "" {
+			return nil, fmt.Errorf("oidc issuer is required")
+		}
+		if cfg.OIDC.JWKSFile == "" {
+			return nil, fmt.Errorf("oidc jwks_file is required")
+		}
+		keys, err := loadJWKS(cfg.OIDC.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.issuer = cfg.OIDC.Issuer
+		a.audience = cfg.OIDC.Audience
+		a.keys = keys
+		a.rolesClaim = cfg.OIDC.RolesClaim
+		if a.rolesClaim == "" {
+			a.rolesClaim = defaultRolesClaim
+		}
+	}
+
+	if len(a.tokens) == 0 && a.issuer == "" {
+		return nil, fmt.Errorf("auth config must define tokens or an oidc issuer")
+	}
+	return a, nil
+}
+
+// Authenticate resolves a bearer token to a principal. Tokens with three dot-separated parts are
+// validated as JWTs when an OIDC issuer is configured; all other tokens are looked up as static tokens.
+func (a *Authenticator) Authenticate(token string) (*Principal, error) {
+	if token == "" {
+		return nil, fmt.Errorf("missing bearer token")
+	}
+	if a.issuer != "" && strings.Count(token, ".") == 2 {
+		return a.authenticateJWT(token)
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	for _, static := range a.tokens {
+		if subtle.ConstantTimeCompare(hash[:], static.hash) == 1 {
+			return static.principal, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid token")
+}
+
+func (a *Authenticator) authenticateJWT(token string) (*Principal, error) {
+	claims, err := verifyJWT(token, a.keys)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.now()
+	if claims.ExpiresAt.IsZero() || now.After(claims.ExpiresAt.Add(clockSkew)) {
+		return nil, fmt.Errorf("token is expired")
+	}
+	if !claims.NotBefore.IsZero() && now.Add(clockSkew).Before(claims.NotBefore) {
+		return nil, fmt.Errorf("token is not valid yet")
+	}
+	if claims.Issuer != a.issuer {
+		return nil, fmt.Errorf("token issuer %q is not trusted", claims.Issuer)
+	}
+	if a.audience != "" && !slices.Contains(claims.Audience, a.audience) {
+		return nil, fmt.Errorf("token audience does not include %q", a.audience)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+
+	// Unknown grants are skipped, so roles of other applications can share the claim
+	var grants []Grant
+	for _, value := range stringList(claims.all[a.rolesClaim]) {
+		if grant, err := ParseGrant(value); err == nil {
+			grants = append(grants, grant)
+		}
+	}
+	return &Principal{Subject: claims.Subject, Grants: grants}, nil
+}
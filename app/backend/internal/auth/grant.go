@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// Role is a set of permissions granted to a principal.
+type Role string
+
+const (
+	// RoleViewer reads cloud resources, stack-updates and credentials with redacted secrets.
+	RoleViewer Role = "viewer"
+	// RoleDeployer has the permissions of a viewer, manages cloud resources and runs stack-updates.
+	RoleDeployer Role = "deployer"
+	// RoleCredentialAdmin has the permissions of a viewer, manages credentials and reads their secrets.
+	RoleCredentialAdmin Role = "credential-admin"
+)
+
+// includes reports whether r has the permissions of required.
+func (r Role) includes(required Role) bool {
+	return r == required || required == RoleViewer && (r == RoleDeployer || r == RoleCredentialAdmin)
+}
+
+// anyScope matches every org or env in a grant.
+const anyScope = "*"
+
+// Grant gives a role on the cloud resources of an org, or of an env within that org.
+// An empty Org or Env matches all of them.
+type Grant struct {
+	Role Role
+	Org  string
+	Env  string
+}
+
+// ParseGrant parses a grant written as <role>[:<org>[:<env>]], e.g. "deployer:acme:prod".
+// An org or env of "*" matches all of them.
+func ParseGrant(value string) (Grant, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) > 3 {
+		return Grant{}, fmt.Errorf("invalid grant %q: expected <role>[:<org>[:<env>]]", value)
+	}
+
+	grant := Grant{Role: Role(parts[0])}
+	switch grant.Role {
+	case RoleViewer, RoleDeployer, RoleCredentialAdmin:
+	default:
+		return Grant{}, fmt.Errorf("invalid grant %q: unknown role %q", value, parts[0])
+	}
+	if len(parts) > 1 && parts[1] != anyScope {
+		grant.Org = parts[1]
+	}
+	if len(parts) > 2 && parts[2] != anyScope {
+		grant.Env = parts[2]
+	}
+	if grant.Org == "" && grant.Env != "" {
+		return Grant{}, fmt.Errorf("invalid grant %q: env requires an org", value)
+	}
+	return grant, nil
+}
+
+// ParseGrants parses every grant in values.
+func ParseGrants(values []string) ([]Grant, error) {
+	grants := make([]Grant, 0, len(values))
+	for _, value := range values {
+		grant, err := ParseGrant(value)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// String formats the grant the way ParseGrant reads it.
+func (g Grant) String() string {
+	switch {
+	case g.Env != "":
+		return fmt.Sprintf("%s:%s:%s", g.Role, g.Org, g.Env)
+	case g.Org != "":
+		return fmt.Sprintf("%s:%s", g.Role, g.Org)
+	default:
+		return string(g.Role)
+	}
+}
+
+func (g Grant) allows(role Role, org, env string) bool {
+	return g.Role.includes(role) && (g.Org == "" || g.Org == org) && (g.Env == "" || g.Env == env)
+}
+
+// Scope is an org, or an env within an org, that a principal holds a role on.
+// An empty Env covers every env of the org.
+type Scope struct {
+	Org string
+	Env string
+}
+
+// Principal is an authenticated caller of the API.
+type Principal struct {
+	// Subject names the caller: the static token name or the JWT subject.
+	Subject string
+	Grants  []Grant
+}
+
+// HasRole reports whether the principal holds role on at least one scope.
+func (p *Principal) HasRole(role Role) bool {
+	for _, grant := range p.Grants {
+		if grant.Role.includes(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows reports whether the principal holds role on cloud resources of org and env.
+func (p *Principal) Allows(role Role, org, env string) bool {
+	for _, grant := range p.Grants {
+		if grant.allows(role, org, env) {
+			return true
+		}
+	}
+	return false
+}
+
+// Scopes returns the scopes the principal holds role on. all is true when one of its grants is unscoped.
+func (p *Principal) Scopes(role Role) (scopes []Scope, all bool) {
+	for _, grant := range p.Grants {
+		if !grant.Role.includes(role) {
+			continue
+		}
+		if grant.Org == "" {
+			return nil, true
+		}
+		scopes = append(scopes, Scope{Org: grant.Org, Env: grant.Env})
+	}
+	return scopes, false
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal authenticated for the request, or nil when
+// authentication is disabled or ctx does not belong to a request.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// Authorize checks that the caller holds role on cloud resources of org and env.
+// Contexts without a principal are allowed, since the interceptor rejects unauthenticated requests.
+// The returned error is a connect error.
+func Authorize(ctx context.Context, role Role, org, env string) error {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil || principal.Allows(role, org, env) {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied,
+		fmt.Errorf("%s does not have role %s on org %q env %q", principal.Subject, role, org, env))
+}
+
+// VisibleScopes returns the scopes the caller holds role on. all is true when the caller is not limited
+// to any scope, including when authentication is disabled.
+func VisibleScopes(ctx context.Context, role Role) (scopes []Scope, all bool) {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil, true
+	}
+	return principal.Scopes(role)
+}
+
+// AuthorizeUnscoped checks that the caller holds role through a grant that is not limited to an org.
+// It guards credentials, which belong to no org or env. The returned error is a connect error.
+func AuthorizeUnscoped(ctx context.Context, role Role) error {
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil
+	}
+	if _, all := principal.Scopes(role); all {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied,
+		fmt.Errorf("%s needs an unscoped %s grant, since credentials belong to no org", principal.Subject, role))
+}
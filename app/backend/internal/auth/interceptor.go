@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	cloudresourcev1connect "github.com/plantonhq/openmcf/apis/org/openmcf/app/cloudresource/v1/cloudresourcev1connect"
+	credentialv1connect "github.com/plantonhq/openmcf/apis/org/openmcf/app/credential/v1/credentialv1connect"
+	stackupdatev1connect "github.com/plantonhq/openmcf/apis/org/openmcf/app/stackupdate/v1/stackupdatev1connect"
+)
+
+// procedureRoles is the role a caller must hold on at least one scope to call a procedure.
+// Services check the org and env of the cloud resources a call touches.
+var procedureRoles = map[string]Role{
+	cloudresourcev1connect.CloudResourceQueryControllerListProcedure:  RoleViewer,
+	cloudresourcev1connect.CloudResourceQueryControllerGetProcedure:   RoleViewer,
+	cloudresourcev1connect.CloudResourceQueryControllerCountProcedure: RoleViewer,
+
+	cloudresourcev1connect.CloudResourceCommandControllerCreateProcedure: RoleDeployer,
+	cloudresourcev1connect.CloudResourceCommandControllerUpdateProcedure: RoleDeployer,
+	cloudresourcev1connect.CloudResourceCommandControllerDeleteProcedure: RoleDeployer,
+	cloudresourcev1connect.CloudResourceCommandControllerApplyProcedure:  RoleDeployer,
+
+	stackupdatev1connect.StackUpdateQueryControllerGetStackUpdateProcedure:          RoleViewer,
+	stackupdatev1connect.StackUpdateQueryControllerListStackUpdatesProcedure:        RoleViewer,
+	stackupdatev1connect.StackUpdateQueryControllerStreamStackUpdateOutputProcedure: RoleViewer,
+
+	stackupdatev1connect.StackUpdateCommandControllerDeployCloudResourceProcedure:  RoleDeployer,
+	stackupdatev1connect.StackUpdateCommandControllerDestroyCloudResourceProcedure: RoleDeployer,
+	stackupdatev1connect.StackUpdateCommandControllerPreviewCloudResourceProcedure: RoleDeployer,
+	stackupdatev1connect.StackUpdateCommandControllerRefreshCloudResourceProcedure: RoleDeployer,
+
+	credentialv1connect.CredentialQueryControllerListProcedure: RoleViewer,
+	credentialv1connect.CredentialQueryControllerGetProcedure:  RoleViewer,
+
+	credentialv1connect.CredentialCommandControllerCreateProcedure: RoleCredentialAdmin,
+	credentialv1connect.CredentialCommandControllerUpdateProcedure: RoleCredentialAdmin,
+	credentialv1connect.CredentialCommandControllerDeleteProcedure: RoleCredentialAdmin,
+}
+
+// Interceptor authenticates the bearer token of every request and checks the role of its procedure.
+// The principal is stored in the request context for the scope checks of the services.
+type Interceptor struct {
+	authenticator *Authenticator
+}
+
+// NewInterceptor creates an interceptor that authenticates requests with authenticator.
+func NewInterceptor(authenticator *Authenticator) *Interceptor {
+	return &Interceptor{authenticator: authenticator}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authorize(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor. Client streams are left unchanged.
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authorize(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *Interceptor) authorize(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	role, ok := procedureRoles[procedure]
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("procedure %s is not authorized", procedure))
+	}
+
+	token, found := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !found {
+		token = ""
+	}
+	principal, err := i.authenticator.Authenticate(strings.TrimSpace(token))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !principal.HasRole(role) {
+		return nil, connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("%s does not have role %s", principal.Subject, role))
+	}
+	return WithPrincipal(ctx, principal), nil
+}
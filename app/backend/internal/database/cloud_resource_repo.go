@@ -91,7 +91,9 @@ func (r *CloudResourceRepository) Create(ctx context.Context, resource *models.C
 
 // CloudResourceListOptions contains options for listing cloud resources.
 type CloudResourceListOptions struct {
-	Kind     *string
+	Kind *string
+	// Scopes, if set, limits the results to cloud resources in one of the scopes.
+	Scopes   []ResourceScope
 	PageNum  *int32
 	PageSize *int32
 }
@@ -104,6 +106,7 @@ func (r *CloudResourceRepository) List(ctx context.Context, opts *CloudResourceL
 		if opts.Kind != nil && *opts.Kind != "" {
 			filter["kind"] = *opts.Kind
 		}
+		addScopeFilter(filter, opts.Scopes)
 	}
 
 	findOptions := options.Find()
@@ -150,6 +153,8 @@ func (r *CloudResourceRepository) Update(ctx context.Context, id string, resourc
 		"$set": bson.M{
 			"name":       resource.Name,
 			"kind":       resource.Kind,
+			"org":        resource.Org,
+			"env":        resource.Env,
 			"manifest":   resource.Manifest,
 			"updated_at": resource.UpdatedAt,
 		},
@@ -202,6 +207,7 @@ func (r *CloudResourceRepository) Count(ctx context.Context, opts *CloudResource
 		if opts.Kind != nil && *opts.Kind != "" {
 			filter["kind"] = *opts.Kind
 		}
+		addScopeFilter(filter, opts.Scopes)
 	}
 
 	count, err := r.collection.CountDocuments(ctx, filter)
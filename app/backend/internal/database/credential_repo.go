@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/plantonhq/openmcf/app/backend/internal/encryption"
 	"github.com/plantonhq/openmcf/app/backend/pkg/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -16,27 +17,44 @@ const (
 	CredentialCollectionName = "credentials"
 )
 
+// credentialSecretFields lists the document fields of each provider that are sealed at rest.
+// Providers stored as a provider config have the whole config sealed.
+var credentialSecretFields = map[string][]string{
+	"gcp":           {"service_account_key_base64"},
+	"aws":           {"secret_access_key", "session_token"},
+	"azure":         {"client_secret"},
+	"auth0":         {"client_secret"},
+	"atlas":         {"provider_config"},
+	"civo":          {"provider_config"},
+	"cloudflare":    {"provider_config"},
+	"confluent":     {"provider_config"},
+	"digital_ocean": {"provider_config"},
+	"kubernetes":    {"provider_config"},
+	"snowflake":     {"provider_config"},
+	"open_fga":      {"provider_config"},
+}
+
 // CredentialRepository provides unified data access for all provider credentials.
+// With an envelope, secret fields are sealed before they are written and opened after they are read,
+// so callers always see plaintext.
 type CredentialRepository struct {
 	collection *mongo.Collection
+	secrets    *encryption.Envelope
 }
 
 // NewCredentialRepository creates a new unified credential repository instance.
-func NewCredentialRepository(db *MongoDB) *CredentialRepository {
+// secrets may be nil, in which case secret fields are stored as plaintext.
+func NewCredentialRepository(db *MongoDB, secrets *encryption.Envelope) *CredentialRepository {
 	return &CredentialRepository{
 		collection: db.Database.Collection(CredentialCollectionName),
+		secrets:    secrets,
 	}
 }
 
 // CreateGcp creates a new GCP credential.
 func (r *CredentialRepository) CreateGcp(ctx context.Context, name, serviceAccountKeyBase64 string) (*models.GcpCredential, error) {
-	// Check if a credential for this provider already exists
-	exists, err := r.ExistsByProvider(ctx, "gcp")
-	if err != nil {
-		return nil, fmt.Errorf("failed to check for existing GCP credential: %w", err)
-	}
-	if exists {
-		return nil, fmt.Errorf("credential for provider 'gcp' already exists")
+	if err := r.ensureNameAvailable(ctx, "gcp", name, primitive.NilObjectID); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -58,7 +76,11 @@ func (r *CredentialRepository) CreateGcp(ctx context.Context, name, serviceAccou
 		"updated_at":                 credential.UpdatedAt,
 	}
 
-	_, err = r.collection.InsertOne(ctx, doc)
+	if err := r.sealSecrets(ctx, "gcp", doc); err != nil {
+		return nil, err
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GCP credential: %w", err)
 	}
@@ -68,13 +90,8 @@ func (r *CredentialRepository) CreateGcp(ctx context.Context, name, serviceAccou
 
 // CreateAws creates a new AWS credential.
 func (r *CredentialRepository) CreateAws(ctx context.Context, name, accountID, accessKeyID, secretAccessKey, region, sessionToken string) (*models.AwsCredential, error) {
-	// Check if a credential for this provider already exists
-	exists, err := r.ExistsByProvider(ctx, "aws")
-	if err != nil {
-		return nil, fmt.Errorf("failed to check for existing AWS credential: %w", err)
-	}
-	if exists {
-		return nil, fmt.Errorf("credential for provider 'aws' already exists")
+	if err := r.ensureNameAvailable(ctx, "aws", name, primitive.NilObjectID); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -107,7 +124,11 @@ func (r *CredentialRepository) CreateAws(ctx context.Context, name, accountID, a
 		doc["session_token"] = sessionToken
 	}
 
-	_, err = r.collection.InsertOne(ctx, doc)
+	if err := r.sealSecrets(ctx, "aws", doc); err != nil {
+		return nil, err
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create AWS credential: %w", err)
 	}
@@ -117,13 +138,8 @@ func (r *CredentialRepository) CreateAws(ctx context.Context, name, accountID, a
 
 // CreateAzure creates a new Azure credential.
 func (r *CredentialRepository) CreateAzure(ctx context.Context, name, clientID, clientSecret, tenantID, subscriptionID string) (*models.AzureCredential, error) {
-	// Check if a credential for this provider already exists
-	exists, err := r.ExistsByProvider(ctx, "azure")
-	if err != nil {
-		return nil, fmt.Errorf("failed to check for existing Azure credential: %w", err)
-	}
-	if exists {
-		return nil, fmt.Errorf("credential for provider 'azure' already exists")
+	if err := r.ensureNameAvailable(ctx, "azure", name, primitive.NilObjectID); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -150,7 +166,11 @@ func (r *CredentialRepository) CreateAzure(ctx context.Context, name, clientID,
 		"updated_at":      credential.UpdatedAt,
 	}
 
-	_, err = r.collection.InsertOne(ctx, doc)
+	if err := r.sealSecrets(ctx, "azure", doc); err != nil {
+		return nil, err
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Azure credential: %w", err)
 	}
@@ -165,6 +185,10 @@ func (r *CredentialRepository) UpdateGcp(ctx context.Context, id string, name, s
 		return nil, fmt.Errorf("invalid ID format: %w", err)
 	}
 
+	if err := r.ensureNameAvailable(ctx, "gcp", name, objectID); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	update := bson.M{
 		"$set": bson.M{
@@ -174,6 +198,10 @@ func (r *CredentialRepository) UpdateGcp(ctx context.Context, id string, name, s
 		},
 	}
 
+	if err := r.sealSecrets(ctx, "gcp", update["$set"].(bson.M)); err != nil {
+		return nil, err
+	}
+
 	result := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "provider": "gcp"}, update)
 	if result.Err() == mongo.ErrNoDocuments {
 		return nil, fmt.Errorf("GCP credential with ID '%s' not found", id)
@@ -201,6 +229,10 @@ func (r *CredentialRepository) UpdateAws(ctx context.Context, id string, name, a
 		return nil, fmt.Errorf("invalid ID format: %w", err)
 	}
 
+	if err := r.ensureNameAvailable(ctx, "aws", name, objectID); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	setFields := bson.M{
 		"name":              name,
@@ -227,6 +259,10 @@ func (r *CredentialRepository) UpdateAws(ctx context.Context, id string, name, a
 		update["$unset"] = unsetFields
 	}
 
+	if err := r.sealSecrets(ctx, "aws", update["$set"].(bson.M)); err != nil {
+		return nil, err
+	}
+
 	result := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "provider": "aws"}, update)
 	if result.Err() == mongo.ErrNoDocuments {
 		return nil, fmt.Errorf("AWS credential with ID '%s' not found", id)
@@ -249,13 +285,8 @@ func (r *CredentialRepository) UpdateAws(ctx context.Context, id string, name, a
 
 // CreateAuth0 creates a new Auth0 credential.
 func (r *CredentialRepository) CreateAuth0(ctx context.Context, name, domain, clientID, clientSecret string) (*models.Auth0Credential, error) {
-	// Check if a credential for this provider already exists
-	exists, err := r.ExistsByProvider(ctx, "auth0")
-	if err != nil {
-		return nil, fmt.Errorf("failed to check for existing Auth0 credential: %w", err)
-	}
-	if exists {
-		return nil, fmt.Errorf("credential for provider 'auth0' already exists")
+	if err := r.ensureNameAvailable(ctx, "auth0", name, primitive.NilObjectID); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -280,7 +311,11 @@ func (r *CredentialRepository) CreateAuth0(ctx context.Context, name, domain, cl
 		"updated_at":    credential.UpdatedAt,
 	}
 
-	_, err = r.collection.InsertOne(ctx, doc)
+	if err := r.sealSecrets(ctx, "auth0", doc); err != nil {
+		return nil, err
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Auth0 credential: %w", err)
 	}
@@ -295,6 +330,10 @@ func (r *CredentialRepository) UpdateAuth0(ctx context.Context, id string, name,
 		return nil, fmt.Errorf("invalid ID format: %w", err)
 	}
 
+	if err := r.ensureNameAvailable(ctx, "auth0", name, objectID); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	update := bson.M{
 		"$set": bson.M{
@@ -306,6 +345,10 @@ func (r *CredentialRepository) UpdateAuth0(ctx context.Context, id string, name,
 		},
 	}
 
+	if err := r.sealSecrets(ctx, "auth0", update["$set"].(bson.M)); err != nil {
+		return nil, err
+	}
+
 	result := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "provider": "auth0"}, update)
 	if result.Err() == mongo.ErrNoDocuments {
 		return nil, fmt.Errorf("Auth0 credential with ID '%s' not found", id)
@@ -333,6 +376,10 @@ func (r *CredentialRepository) UpdateAzure(ctx context.Context, id string, name,
 		return nil, fmt.Errorf("invalid ID format: %w", err)
 	}
 
+	if err := r.ensureNameAvailable(ctx, "azure", name, objectID); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	update := bson.M{
 		"$set": bson.M{
@@ -345,6 +392,10 @@ func (r *CredentialRepository) UpdateAzure(ctx context.Context, id string, name,
 		},
 	}
 
+	if err := r.sealSecrets(ctx, "azure", update["$set"].(bson.M)); err != nil {
+		return nil, err
+	}
+
 	result := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "provider": "azure"}, update)
 	if result.Err() == mongo.ErrNoDocuments {
 		return nil, fmt.Errorf("azure credential with ID '%s' not found", id)
@@ -365,60 +416,165 @@ func (r *CredentialRepository) UpdateAzure(ctx context.Context, id string, name,
 	return convertToAzureCredential(doc)
 }
 
-// Delete deletes a credential by ID.
-func (r *CredentialRepository) Delete(ctx context.Context, id string) error {
+// CreateWithConfig creates a credential of a provider stored as its provider config.
+// providerConfig is the provider config proto as JSON.
+func (r *CredentialRepository) CreateWithConfig(ctx context.Context, name, provider, providerConfig string) (*models.ProviderConfigCredential, error) {
+	if err := r.ensureNameAvailable(ctx, provider, name, primitive.NilObjectID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	credential := &models.ProviderConfigCredential{
+		ID:             primitive.NewObjectID(),
+		Name:           name,
+		Provider:       provider,
+		ProviderConfig: providerConfig,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	doc := bson.M{
+		"_id":             credential.ID,
+		"name":            credential.Name,
+		"provider":        provider,
+		"provider_config": credential.ProviderConfig,
+		"created_at":      credential.CreatedAt,
+		"updated_at":      credential.UpdatedAt,
+	}
+
+	if err := r.sealSecrets(ctx, provider, doc); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return nil, fmt.Errorf("failed to create %s credential: %w", provider, err)
+	}
+
+	return credential, nil
+}
+
+// UpdateWithConfig updates a credential of a provider stored as its provider config.
+func (r *CredentialRepository) UpdateWithConfig(ctx context.Context, id, name, provider, providerConfig string) (*models.ProviderConfigCredential, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	if err := r.ensureNameAvailable(ctx, provider, name, objectID); err != nil {
+		return nil, err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":            name,
+			"provider_config": providerConfig,
+			"updated_at":      time.Now(),
+		},
+	}
+
+	if err := r.sealSecrets(ctx, provider, update["$set"].(bson.M)); err != nil {
+		return nil, err
+	}
+
+	result := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "provider": provider}, update)
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("%s credential with ID '%s' not found", provider, id)
+	}
+	if result.Err() != nil {
+		return nil, fmt.Errorf("failed to update %s credential: %w", provider, result.Err())
+	}
+
+	doc, err := r.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("credential not found after update")
+	}
+
+	return convertToProviderConfigCredential(doc)
+}
+
+// SetDefaultForEnvs replaces the environments a credential is the default for.
+func (r *CredentialRepository) SetDefaultForEnvs(ctx context.Context, id string, envs []string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return fmt.Errorf("invalid ID format: %w", err)
 	}
 
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if envs == nil {
+		envs = []string{}
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{"default_for_envs": envs}})
 	if err != nil {
-		return fmt.Errorf("failed to delete credential: %w", err)
+		return fmt.Errorf("failed to update default environments of credential: %w", err)
 	}
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		return fmt.Errorf("credential with ID '%s' not found", id)
 	}
-
 	return nil
 }
 
-// FindFirstByProvider retrieves the first credential for a given provider.
-func (r *CredentialRepository) FindFirstByProvider(ctx context.Context, provider string) (interface{}, error) {
-	filter := bson.M{"provider": provider}
+// CheckDefaultForEnvs returns an error when another credential of the provider is already the default
+// for one of envs. excludeID is the credential being updated, or empty for a new credential.
+func (r *CredentialRepository) CheckDefaultForEnvs(ctx context.Context, provider, excludeID string, envs []string) error {
+	if len(envs) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"provider": provider, "default_for_envs": bson.M{"$in": envs}}
+	if excludeID != "" {
+		objectID, err := primitive.ObjectIDFromHex(excludeID)
+		if err != nil {
+			return fmt.Errorf("invalid ID format: %w", err)
+		}
+		filter["_id"] = bson.M{"$ne": objectID}
+	}
 
-	var result bson.M
-	err := r.collection.FindOne(ctx, filter).Decode(&result)
+	var existing bson.M
+	err := r.collection.FindOne(ctx, filter).Decode(&existing)
 	if err == mongo.ErrNoDocuments {
-		return nil, nil
+		return nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to find %s credential: %w", provider, err)
-	}
-
-	// Convert to appropriate model based on provider
-	switch provider {
-	case "gcp":
-		return convertToGcpCredential(result)
-	case "aws":
-		return convertToAwsCredential(result)
-	case "azure":
-		return convertToAzureCredential(result)
-	case "auth0":
-		return convertToAuth0Credential(result)
-	default:
-		return nil, fmt.Errorf("unsupported provider: %s", provider)
+		return fmt.Errorf("failed to check default environments: %w", err)
 	}
+	return fmt.Errorf("%s credential '%v' is already the default for one of the environments %v", provider, existing["name"], envs)
 }
 
-// ExistsByProvider checks if a credential exists for the given provider.
-func (r *CredentialRepository) ExistsByProvider(ctx context.Context, provider string) (bool, error) {
-	filter := bson.M{"provider": provider}
+// ensureNameAvailable returns an error when another credential of the provider has the name.
+// excludeID is the credential being updated, or NilObjectID for a new credential.
+func (r *CredentialRepository) ensureNameAvailable(ctx context.Context, provider, name string, excludeID primitive.ObjectID) error {
+	filter := bson.M{"provider": provider, "name": name}
+	if !excludeID.IsZero() {
+		filter["_id"] = bson.M{"$ne": excludeID}
+	}
 	count, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
-		return false, fmt.Errorf("failed to check credential existence: %w", err)
+		return fmt.Errorf("failed to check for existing %s credential: %w", provider, err)
+	}
+	if count > 0 {
+		return fmt.Errorf("credential named '%s' already exists for provider '%s'", name, provider)
 	}
-	return count > 0, nil
+	return nil
+}
+
+// Delete deletes a credential by ID.
+func (r *CredentialRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("credential with ID '%s' not found", id)
+	}
+
+	return nil
 }
 
 // FindByID retrieves a credential by ID.
@@ -436,11 +592,14 @@ func (r *CredentialRepository) FindByID(ctx context.Context, id string) (bson.M,
 	if err != nil {
 		return nil, fmt.Errorf("failed to query credential by ID: %w", err)
 	}
+	if err := r.openSecrets(ctx, result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
 // List retrieves all credentials with optional provider filter.
-// Returns credential summaries (without sensitive data like keys/secrets).
+// Secret fields are opened like in FindByID; callers decide what to expose.
 func (r *CredentialRepository) List(ctx context.Context, provider *string) ([]bson.M, error) {
 	filter := bson.M{}
 	if provider != nil && *provider != "" {
@@ -457,10 +616,102 @@ func (r *CredentialRepository) List(ctx context.Context, provider *string) ([]bs
 	if err := cursor.All(ctx, &results); err != nil {
 		return nil, fmt.Errorf("failed to decode credentials: %w", err)
 	}
+	for _, result := range results {
+		if err := r.openSecrets(ctx, result); err != nil {
+			return nil, err
+		}
+	}
 
 	return results, nil
 }
 
+// Rekey seals every secret field with the primary key of the envelope. It covers secrets stored as plaintext
+// before encryption was enabled and secrets sealed with a previous key. Returns the number of credentials rewritten.
+func (r *CredentialRepository) Rekey(ctx context.Context) (int, error) {
+	if r.secrets == nil {
+		return 0, fmt.Errorf("credential encryption is not configured")
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, fmt.Errorf("failed to decode credentials: %w", err)
+	}
+
+	rekeyed := 0
+	for _, doc := range docs {
+		provider, _ := doc["provider"].(string)
+		setFields := bson.M{}
+		for _, field := range credentialSecretFields[provider] {
+			value, ok := doc[field].(string)
+			if !ok || value == "" || !r.secrets.NeedsRekey(value) {
+				continue
+			}
+			plaintext, err := r.secrets.Open(ctx, value)
+			if err != nil {
+				return rekeyed, fmt.Errorf("failed to open %s of credential %v: %w", field, doc["_id"], err)
+			}
+			setFields[field] = plaintext
+		}
+		if len(setFields) == 0 {
+			continue
+		}
+		if err := r.sealSecrets(ctx, provider, setFields); err != nil {
+			return rekeyed, err
+		}
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": doc["_id"]}, bson.M{"$set": setFields}); err != nil {
+			return rekeyed, fmt.Errorf("failed to update credential %v: %w", doc["_id"], err)
+		}
+		rekeyed++
+	}
+	return rekeyed, nil
+}
+
+// sealSecrets seals the secret fields of the provider present in fields, in place.
+func (r *CredentialRepository) sealSecrets(ctx context.Context, provider string, fields bson.M) error {
+	if r.secrets == nil {
+		return nil
+	}
+	for _, field := range credentialSecretFields[provider] {
+		value, ok := fields[field].(string)
+		if !ok || value == "" || encryption.IsSealed(value) {
+			continue
+		}
+		sealed, err := r.secrets.Seal(ctx, value)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt %s: %w", field, err)
+		}
+		fields[field] = sealed
+	}
+	return nil
+}
+
+// openSecrets opens the sealed secret fields of a credential document, in place.
+// Plaintext values are left as they are.
+func (r *CredentialRepository) openSecrets(ctx context.Context, doc bson.M) error {
+	provider, _ := doc["provider"].(string)
+	for _, field := range credentialSecretFields[provider] {
+		value, ok := doc[field].(string)
+		if !ok || !encryption.IsSealed(value) {
+			continue
+		}
+		if r.secrets == nil {
+			return fmt.Errorf("credential %v is encrypted but credential encryption is not configured", doc["_id"])
+		}
+		plaintext, err := r.secrets.Open(ctx, value)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s of credential %v: %w", field, doc["_id"], err)
+		}
+		doc[field] = plaintext
+	}
+	return nil
+}
+
 // Helper functions to convert bson.M to typed credentials
 func convertToGcpCredential(doc bson.M) (*models.GcpCredential, error) {
 	id, ok := doc["_id"].(primitive.ObjectID)
@@ -573,3 +824,32 @@ func convertToAuth0Credential(doc bson.M) (*models.Auth0Credential, error) {
 		UpdatedAt:    updatedAt,
 	}, nil
 }
+
+func convertToProviderConfigCredential(doc bson.M) (*models.ProviderConfigCredential, error) {
+	id, ok := doc["_id"].(primitive.ObjectID)
+	if !ok {
+		return nil, fmt.Errorf("invalid _id field")
+	}
+
+	// Convert primitive.DateTime to time.Time
+	var createdAt, updatedAt time.Time
+	if dt, ok := doc["created_at"].(primitive.DateTime); ok {
+		createdAt = dt.Time()
+	}
+	if dt, ok := doc["updated_at"].(primitive.DateTime); ok {
+		updatedAt = dt.Time()
+	}
+
+	cred := &models.ProviderConfigCredential{
+		ID:        id,
+		Name:      doc["name"].(string),
+		Provider:  doc["provider"].(string),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if providerConfig, ok := doc["provider_config"].(string); ok {
+		cred.ProviderConfig = providerConfig
+	}
+
+	return cred, nil
+}
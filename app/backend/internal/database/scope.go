@@ -0,0 +1,25 @@
+package database
+
+import "go.mongodb.org/mongo-driver/bson"
+
+// ResourceScope limits a query to the documents of an org, or of an env within it when Env is set.
+type ResourceScope struct {
+	Org string
+	Env string
+}
+
+// addScopeFilter restricts filter to documents in one of scopes. Empty scopes leave filter unrestricted.
+func addScopeFilter(filter bson.M, scopes []ResourceScope) {
+	if len(scopes) == 0 {
+		return
+	}
+	conditions := make(bson.A, 0, len(scopes))
+	for _, scope := range scopes {
+		condition := bson.M{"org": scope.Org}
+		if scope.Env != "" {
+			condition["env"] = scope.Env
+		}
+		conditions = append(conditions, condition)
+	}
+	filter["$or"] = conditions
+}
@@ -131,8 +131,10 @@ func (r *StackUpdateRepository) Update(ctx context.Context, id string, stackUpda
 type StackUpdateListOptions struct {
 	CloudResourceID *string
 	Status          *string
-	PageNum         *int32
-	PageSize        *int32
+	// Scopes, if set, limits the results to stack-updates of cloud resources in one of the scopes.
+	Scopes   []ResourceScope
+	PageNum  *int32
+	PageSize *int32
 }
 
 // List retrieves stack-updates with optional filters and pagination.
@@ -147,6 +149,7 @@ func (r *StackUpdateRepository) List(ctx context.Context, opts *StackUpdateListO
 		if opts.Status != nil && *opts.Status != "" {
 			filter["status"] = *opts.Status
 		}
+		addScopeFilter(filter, opts.Scopes)
 	}
 
 	findOptions := options.Find()
@@ -191,6 +194,7 @@ func (r *StackUpdateRepository) Count(ctx context.Context, opts *StackUpdateList
 		if opts.Status != nil && *opts.Status != "" {
 			filter["status"] = *opts.Status
 		}
+		addScopeFilter(filter, opts.Scopes)
 	}
 
 	count, err := r.collection.CountDocuments(ctx, filter)
@@ -0,0 +1,82 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"filippo.io/age"
+)
+
+// AgeKeyProvider wraps data keys with an age X25519 identity.
+type AgeKeyProvider struct {
+	identity  *age.X25519Identity
+	recipient *age.X25519Recipient
+	keyID     string
+}
+
+// NewAgeKeyProvider creates a key provider from an age X25519 identity.
+func NewAgeKeyProvider(identity *age.X25519Identity) *AgeKeyProvider {
+	recipient := identity.Recipient()
+	fingerprint := sha256.Sum256([]byte(recipient.String()))
+	return &AgeKeyProvider{
+		identity:  identity,
+		recipient: recipient,
+		keyID:     "age-" + hex.EncodeToString(fingerprint[:8]),
+	}
+}
+
+// LoadAgeKeyProvider reads an age identity file as written by age-keygen.
+// The file must hold exactly one X25519 identity.
+func LoadAgeKeyProvider(path string) (*AgeKeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read age identity file %s: %w", path, err)
+	}
+	identities, err := age.ParseIdentities(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid age identity file %s: %w", path, err)
+	}
+	if len(identities) != 1 {
+		return nil, fmt.Errorf("age identity file %s must hold exactly one identity, found %d", path, len(identities))
+	}
+	identity, ok := identities[0].(*age.X25519Identity)
+	if !ok {
+		return nil, fmt.Errorf("age identity file %s must hold an X25519 identity", path)
+	}
+	return NewAgeKeyProvider(identity), nil
+}
+
+// KeyID returns "age-" followed by a fingerprint of the age recipient.
+func (p *AgeKeyProvider) KeyID() string {
+	return p.keyID
+}
+
+// WrapKey encrypts the data key to the recipient of the identity.
+func (p *AgeKeyProvider) WrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	var wrapped bytes.Buffer
+	writer, err := age.Encrypt(&wrapped, p.recipient)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(dataKey); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return wrapped.Bytes(), nil
+}
+
+// UnwrapKey decrypts a data key wrapped by WrapKey.
+func (p *AgeKeyProvider) UnwrapKey(_ context.Context, wrappedKey []byte) ([]byte, error) {
+	reader, err := age.Decrypt(bytes.NewReader(wrappedKey), p.identity)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,56 @@
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// KeyFileEnv is the key file new credential secrets are sealed with.
+	KeyFileEnv = "CREDENTIAL_ENCRYPTION_KEY_FILE"
+	// PreviousKeyFilesEnv is a comma-separated list of key files that are only used to open
+	// secrets sealed before a key rotation.
+	PreviousKeyFilesEnv = "CREDENTIAL_ENCRYPTION_PREVIOUS_KEY_FILES"
+)
+
+// EnvelopeFromEnv builds the envelope from CREDENTIAL_ENCRYPTION_KEY_FILE and
+// CREDENTIAL_ENCRYPTION_PREVIOUS_KEY_FILES. It returns nil when no key file is configured.
+func EnvelopeFromEnv() (*Envelope, error) {
+	keyFile := os.Getenv(KeyFileEnv)
+	if keyFile == "" {
+		return nil, nil
+	}
+	primary, err := LoadKeyProvider(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []KeyProvider
+	for _, path := range strings.Split(os.Getenv(PreviousKeyFilesEnv), ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		provider, err := LoadKeyProvider(path)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, provider)
+	}
+	return NewEnvelope(primary, previous...)
+}
+
+// LoadKeyProvider reads a key file. Files holding an AGE-SECRET-KEY- identity are loaded as age identities,
+// everything else as a local AES key.
+func LoadKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", path, err)
+	}
+	if bytes.Contains(content, []byte("AGE-SECRET-KEY-")) {
+		return LoadAgeKeyProvider(path)
+	}
+	return LoadLocalKeyProvider(path)
+}
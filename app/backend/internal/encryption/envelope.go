@@ -0,0 +1,169 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// encryptedPrefix marks values sealed by an Envelope, so plaintext values stored before
+// encryption was enabled can still be read and re-keyed.
+const encryptedPrefix = "enc:v1:"
+
+// dataKeySize is the size of the AES-256 data key generated for every sealed value.
+const dataKeySize = 32
+
+// KeyProvider wraps and unwraps data keys with a key encryption key.
+// Implementations exist for a local AES-GCM key file, an age identity and KMS services.
+type KeyProvider interface {
+	// KeyID identifies the key encryption key. It is stored with every sealed value
+	// to pick the provider that can unwrap its data key.
+	KeyID() string
+	// WrapKey encrypts a data key.
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key wrapped by WrapKey.
+	UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error)
+}
+
+// Envelope seals values with envelope encryption: every value is encrypted with a fresh AES-256-GCM
+// data key, and the data key is wrapped by the primary key provider and stored next to the ciphertext.
+// Previous key providers are only used to open values sealed before a key rotation.
+type Envelope struct {
+	primary   KeyProvider
+	providers map[string]KeyProvider
+}
+
+// NewEnvelope creates an envelope that seals with primary and opens values sealed with primary or any of previous.
+func NewEnvelope(primary KeyProvider, previous ...KeyProvider) (*Envelope, error) {
+	if primary == nil {
+		return nil, fmt.Errorf("primary key provider is required")
+	}
+	providers := map[string]KeyProvider{}
+	for _, provider := range append([]KeyProvider{primary}, previous...) {
+		keyID := provider.KeyID()
+		if strings.ContainsAny(keyID, ".:") || keyID == "" {
+			return nil, fmt.Errorf("invalid key ID %q", keyID)
+		}
+		if _, exists := providers[keyID]; exists {
+			return nil, fmt.Errorf("key %s is configured more than once", keyID)
+		}
+		providers[keyID] = provider
+	}
+	return &Envelope{primary: primary, providers: providers}, nil
+}
+
+// PrimaryKeyID returns the ID of the key new values are sealed with.
+func (e *Envelope) PrimaryKeyID() string {
+	return e.primary.KeyID()
+}
+
+// Seal encrypts plaintext with the primary key provider.
+// The result has the form enc:v1:<key id>.<wrapped data key>.<nonce and ciphertext>.
+func (e *Envelope) Seal(ctx context.Context, plaintext string) (string, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	keyID := e.primary.KeyID()
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), []byte(keyID))
+
+	wrappedKey, err := e.primary.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap data key with %s: %w", keyID, err)
+	}
+
+	return encryptedPrefix + strings.Join([]string{
+		keyID,
+		base64.RawURLEncoding.EncodeToString(wrappedKey),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+	}, "."), nil
+}
+
+// Open decrypts a value sealed by Seal. Values without the enc:v1: prefix are returned as they are.
+func (e *Envelope) Open(ctx context.Context, value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+
+	keyID, wrappedKey, ciphertext, err := parseSealed(value)
+	if err != nil {
+		return "", err
+	}
+	provider, ok := e.providers[keyID]
+	if !ok {
+		return "", fmt.Errorf("value is sealed with unknown key %s", keyID)
+	}
+
+	dataKey, err := provider.UnwrapKey(ctx, wrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to unwrap data key with %s: %w", keyID, err)
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", fmt.Errorf("sealed value is too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value sealed with %s: %w", keyID, err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRekey reports whether value is plaintext or sealed with a key other than the primary key.
+func (e *Envelope) NeedsRekey(value string) bool {
+	if !IsSealed(value) {
+		return true
+	}
+	keyID, _, _, err := parseSealed(value)
+	return err != nil || keyID != e.primary.KeyID()
+}
+
+// IsSealed reports whether value was sealed by an Envelope.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+func parseSealed(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ".")
+	if len(parts) != 3 {
+		return "", nil, nil, fmt.Errorf("malformed sealed value")
+	}
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("malformed wrapped data key: %w", err)
+	}
+	ciphertext, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("malformed ciphertext: %w", err)
+	}
+	return parts[0], wrappedKey, ciphertext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
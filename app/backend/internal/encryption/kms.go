@@ -0,0 +1,48 @@
+package encryption
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// KMSClient is the subset of a key management service used to wrap data keys.
+// Cloud KMS clients (AWS KMS, GCP Cloud KMS, Azure Key Vault, Vault transit) fit it with a thin adapter.
+type KMSClient interface {
+	// Encrypt encrypts plaintext with the named key.
+	Encrypt(ctx context.Context, keyName string, plaintext []byte) ([]byte, error)
+	// Decrypt decrypts ciphertext produced by Encrypt with the named key.
+	Decrypt(ctx context.Context, keyName string, ciphertext []byte) ([]byte, error)
+}
+
+// KMSKeyProvider wraps data keys with a key held by a KMS.
+type KMSKeyProvider struct {
+	client  KMSClient
+	keyName string
+	keyID   string
+}
+
+// NewKMSKeyProvider creates a key provider for the named KMS key.
+func NewKMSKeyProvider(client KMSClient, keyName string) *KMSKeyProvider {
+	fingerprint := sha256.Sum256([]byte(keyName))
+	return &KMSKeyProvider{
+		client:  client,
+		keyName: keyName,
+		keyID:   "kms-" + hex.EncodeToString(fingerprint[:8]),
+	}
+}
+
+// KeyID returns "kms-" followed by a fingerprint of the key name.
+func (p *KMSKeyProvider) KeyID() string {
+	return p.keyID
+}
+
+// WrapKey encrypts the data key with the KMS key.
+func (p *KMSKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return p.client.Encrypt(ctx, p.keyName, dataKey)
+}
+
+// UnwrapKey decrypts a data key wrapped by WrapKey.
+func (p *KMSKeyProvider) UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error) {
+	return p.client.Decrypt(ctx, p.keyName, wrappedKey)
+}
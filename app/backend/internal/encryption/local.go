@@ -0,0 +1,106 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// LocalKeyProvider wraps data keys with an AES-256-GCM key read from a local file.
+type LocalKeyProvider struct {
+	key   []byte
+	keyID string
+}
+
+// NewLocalKeyProvider creates a key provider from a 32-byte AES key.
+func NewLocalKeyProvider(key []byte) (*LocalKeyProvider, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("local key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	fingerprint := sha256.Sum256(key)
+	return &LocalKeyProvider{
+		key:   key,
+		keyID: "local-" + hex.EncodeToString(fingerprint[:8]),
+	}, nil
+}
+
+// LoadLocalKeyProvider reads a local key file. The file holds the 32-byte key as base64,
+// as hex, or as raw bytes.
+func LoadLocalKeyProvider(path string) (*LocalKeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", path, err)
+	}
+	key, err := decodeLocalKey(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %w", path, err)
+	}
+	return NewLocalKeyProvider(key)
+}
+
+// GenerateLocalKeyFile writes a new random key as base64 to path. Existing files are not overwritten.
+func GenerateLocalKeyFile(path string) error {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create key file %s: %w", path, err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(base64.StdEncoding.EncodeToString(key) + "\n"); err != nil {
+		return fmt.Errorf("failed to write key file %s: %w", path, err)
+	}
+	return nil
+}
+
+func decodeLocalKey(content []byte) ([]byte, error) {
+	if len(content) == dataKeySize {
+		return content, nil
+	}
+	trimmed := string(bytes.TrimSpace(content))
+	if key, err := base64.StdEncoding.DecodeString(trimmed); err == nil && len(key) == dataKeySize {
+		return key, nil
+	}
+	if key, err := hex.DecodeString(trimmed); err == nil && len(key) == dataKeySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("expected a %d-byte key as base64, hex or raw bytes", dataKeySize)
+}
+
+// KeyID returns "local-" followed by a fingerprint of the key.
+func (p *LocalKeyProvider) KeyID() string {
+	return p.keyID
+}
+
+// WrapKey encrypts the data key with AES-256-GCM.
+func (p *LocalKeyProvider) WrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	gcm, err := newGCM(p.key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+// UnwrapKey decrypts a data key wrapped by WrapKey.
+func (p *LocalKeyProvider) UnwrapKey(_ context.Context, wrappedKey []byte) ([]byte, error) {
+	gcm, err := newGCM(p.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:gcm.NonceSize()], wrappedKey[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
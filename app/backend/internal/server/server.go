@@ -7,7 +7,10 @@ import (
 	"os"
 	"time"
 
+	"connectrpc.com/connect"
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
+	"github.com/plantonhq/openmcf/app/backend/internal/encryption"
 	"github.com/plantonhq/openmcf/app/backend/internal/service"
 	"github.com/sirupsen/logrus"
 
@@ -28,6 +31,10 @@ type Server struct {
 type Config struct {
 	Port    string
 	MongoDB *database.MongoDB
+	// CredentialSecrets seals credential secrets at rest. Secrets are stored as plaintext when nil.
+	CredentialSecrets *encryption.Envelope
+	// Authenticator authenticates every API request. Authentication is disabled when nil.
+	Authenticator *auth.Authenticator
 }
 
 // corsMiddleware wraps an HTTP handler with CORS headers.
@@ -118,7 +125,7 @@ func NewServer(cfg *Config) *Server {
 	cloudResourceRepo := database.NewCloudResourceRepository(cfg.MongoDB)
 	stackUpdateRepo := database.NewStackUpdateRepository(cfg.MongoDB)
 	stackUpdateStreamingResponseRepo := database.NewStackUpdateStreamingResponseRepository(cfg.MongoDB)
-	credentialRepo := database.NewCredentialRepository(cfg.MongoDB)
+	credentialRepo := database.NewCredentialRepository(cfg.MongoDB, cfg.CredentialSecrets)
 
 	// Create credential resolver
 	credentialResolver := service.NewCredentialResolver(credentialRepo)
@@ -128,30 +135,36 @@ func NewServer(cfg *Config) *Server {
 	cloudResourceService := service.NewCloudResourceService(cloudResourceRepo, stackUpdateService)
 	credentialService := service.NewCredentialService(credentialRepo)
 
+	// Authenticate and authorize every RPC when authentication is configured
+	var handlerOptions []connect.HandlerOption
+	if cfg.Authenticator != nil {
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(auth.NewInterceptor(cfg.Authenticator)))
+	}
+
 	mux := http.NewServeMux()
 
 	// Register the CloudResourceCommandController (Create, Update, Delete, Apply)
-	cloudResourceCommandPath, cloudResourceCommandHandler := cloudresourcev1connect.NewCloudResourceCommandControllerHandler(cloudResourceService)
+	cloudResourceCommandPath, cloudResourceCommandHandler := cloudresourcev1connect.NewCloudResourceCommandControllerHandler(cloudResourceService, handlerOptions...)
 	mux.Handle(cloudResourceCommandPath, corsMiddleware(cloudResourceCommandHandler))
 
 	// Register the CloudResourceQueryController (List, Get, Count)
-	cloudResourceQueryPath, cloudResourceQueryHandler := cloudresourcev1connect.NewCloudResourceQueryControllerHandler(cloudResourceService)
+	cloudResourceQueryPath, cloudResourceQueryHandler := cloudresourcev1connect.NewCloudResourceQueryControllerHandler(cloudResourceService, handlerOptions...)
 	mux.Handle(cloudResourceQueryPath, corsMiddleware(cloudResourceQueryHandler))
 
 	// Register the StackUpdateCommandController (DeployCloudResource)
-	stackUpdateCommandPath, stackUpdateCommandHandler := stackupdatev1connect.NewStackUpdateCommandControllerHandler(stackUpdateService)
+	stackUpdateCommandPath, stackUpdateCommandHandler := stackupdatev1connect.NewStackUpdateCommandControllerHandler(stackUpdateService, handlerOptions...)
 	mux.Handle(stackUpdateCommandPath, corsMiddleware(stackUpdateCommandHandler))
 
 	// Register the StackUpdateQueryController (GetStackUpdate, ListStackUpdates, StreamStackUpdateOutput)
-	stackUpdateQueryPath, stackUpdateQueryHandler := stackupdatev1connect.NewStackUpdateQueryControllerHandler(stackUpdateService)
+	stackUpdateQueryPath, stackUpdateQueryHandler := stackupdatev1connect.NewStackUpdateQueryControllerHandler(stackUpdateService, handlerOptions...)
 	mux.Handle(stackUpdateQueryPath, corsMiddleware(stackUpdateQueryHandler))
 
 	// Register the CredentialCommandController
-	credentialCommandPath, credentialCommandHandler := credentialv1connect.NewCredentialCommandControllerHandler(credentialService)
+	credentialCommandPath, credentialCommandHandler := credentialv1connect.NewCredentialCommandControllerHandler(credentialService, handlerOptions...)
 	mux.Handle(credentialCommandPath, corsMiddleware(credentialCommandHandler))
 
 	// Register the CredentialQueryController
-	credentialQueryPath, credentialQueryHandler := credentialv1connect.NewCredentialQueryControllerHandler(credentialService)
+	credentialQueryPath, credentialQueryHandler := credentialv1connect.NewCredentialQueryControllerHandler(credentialService, handlerOptions...)
 	mux.Handle(credentialQueryPath, corsMiddleware(credentialQueryHandler))
 
 	// Add health check endpoint
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
+	"github.com/plantonhq/openmcf/app/backend/internal/database"
+)
+
+// manifestScope returns the org and env of a manifest from its parsed metadata.
+func manifestScope(metadata map[string]interface{}) (org, env string) {
+	org, _ = metadata["org"].(string)
+	env, _ = metadata["env"].(string)
+	return org, env
+}
+
+// visibleScopes returns the repository scopes of the cloud resources the caller holds role on.
+// scopes is nil when the caller is not limited to any scope, and visible is false when it holds role nowhere.
+func visibleScopes(ctx context.Context, role auth.Role) (scopes []database.ResourceScope, visible bool) {
+	authScopes, all := auth.VisibleScopes(ctx, role)
+	if all {
+		return nil, true
+	}
+	for _, scope := range authScopes {
+		scopes = append(scopes, database.ResourceScope{Org: scope.Org, Env: scope.Env})
+	}
+	return scopes, len(scopes) > 0
+}
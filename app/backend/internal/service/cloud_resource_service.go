@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
 	"github.com/plantonhq/openmcf/app/backend/pkg/models"
 	"gopkg.in/yaml.v3"
@@ -61,6 +62,11 @@ func (s *CloudResourceService) Create(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("manifest must contain 'metadata.name' field"))
 	}
 
+	org, env := manifestScope(metadata)
+	if err := auth.Authorize(ctx, auth.RoleDeployer, org, env); err != nil {
+		return nil, err
+	}
+
 	// Check if a resource with the same name already exists
 	existingResource, err := s.repo.FindByName(ctx, name)
 	if err != nil {
@@ -74,6 +80,8 @@ func (s *CloudResourceService) Create(
 	cloudResource := &models.CloudResource{
 		Name:     name,
 		Kind:     kind,
+		Org:      org,
+		Env:      env,
 		Manifest: manifest,
 	}
 
@@ -85,7 +93,7 @@ func (s *CloudResourceService) Create(
 
 	// Trigger Pulumi deployment automatically (credentials will be resolved from database)
 	if s.stackUpdateService != nil {
-		// Create a deployment request (no provider_config needed - will be resolved automatically)
+		// Create a deployment request (the credential is selected from the manifest)
 		deployReq := &connect.Request[stackupdatev1.DeployCloudResourceRequest]{
 			Msg: &stackupdatev1.DeployCloudResourceRequest{
 				CloudResourceId: createdResource.ID.Hex(),
@@ -94,7 +102,9 @@ func (s *CloudResourceService) Create(
 
 		// Trigger deployment asynchronously (don't wait for it)
 		go func() {
-			_, _ = s.stackUpdateService.DeployCloudResource(context.Background(), deployReq)
+			if _, err := s.stackUpdateService.DeployCloudResource(context.Background(), deployReq); err != nil {
+				fmt.Printf("ERROR: Failed to deploy cloud resource %s: %v\n", deployReq.Msg.CloudResourceId, err)
+			}
 		}()
 	}
 
@@ -123,7 +133,11 @@ func (s *CloudResourceService) List(
 	ctx context.Context,
 	req *connect.Request[cloudresourcev1.ListCloudResourcesRequest],
 ) (*connect.Response[cloudresourcev1.ListCloudResourcesResponse], error) {
-	opts := &database.CloudResourceListOptions{}
+	scopes, visible := visibleScopes(ctx, auth.RoleViewer)
+	if !visible {
+		return connect.NewResponse(&cloudresourcev1.ListCloudResourcesResponse{}), nil
+	}
+	opts := &database.CloudResourceListOptions{Scopes: scopes}
 	if req.Msg.Kind != "" {
 		kind := req.Msg.Kind
 		opts.Kind = &kind
@@ -200,6 +214,9 @@ func (s *CloudResourceService) Get(
 	if resource == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud resource with ID '%s' not found", id))
 	}
+	if err := auth.Authorize(ctx, auth.RoleViewer, resource.Org, resource.Env); err != nil {
+		return nil, err
+	}
 
 	protoResource := &cloudresourcev1.CloudResource{
 		Id:       resource.ID.Hex(),
@@ -243,6 +260,9 @@ func (s *CloudResourceService) Update(
 	if existingResource == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud resource with ID '%s' not found", id))
 	}
+	if err := auth.Authorize(ctx, auth.RoleDeployer, existingResource.Org, existingResource.Env); err != nil {
+		return nil, err
+	}
 
 	// Parse YAML to extract kind and metadata.name
 	var yamlData map[string]interface{}
@@ -278,10 +298,18 @@ func (s *CloudResourceService) Update(
 			fmt.Errorf("manifest kind '%s' does not match existing resource kind '%s'", kind, existingResource.Kind))
 	}
 
+	// Moving a resource to another org or env needs the role on both
+	org, env := manifestScope(metadata)
+	if err := auth.Authorize(ctx, auth.RoleDeployer, org, env); err != nil {
+		return nil, err
+	}
+
 	// Create updated domain model
 	cloudResource := &models.CloudResource{
 		Name:     name,
 		Kind:     kind,
+		Org:      org,
+		Env:      env,
 		Manifest: manifest,
 	}
 
@@ -293,7 +321,7 @@ func (s *CloudResourceService) Update(
 
 	// Trigger Pulumi deployment automatically (credentials will be resolved from database)
 	if s.stackUpdateService != nil {
-		// Create a deployment request (no provider_config needed - will be resolved automatically)
+		// Create a deployment request (the credential is selected from the manifest)
 		deployReq := &connect.Request[stackupdatev1.DeployCloudResourceRequest]{
 			Msg: &stackupdatev1.DeployCloudResourceRequest{
 				CloudResourceId: id,
@@ -302,7 +330,9 @@ func (s *CloudResourceService) Update(
 
 		// Trigger deployment asynchronously (don't wait for it)
 		go func() {
-			_, _ = s.stackUpdateService.DeployCloudResource(context.Background(), deployReq)
+			if _, err := s.stackUpdateService.DeployCloudResource(context.Background(), deployReq); err != nil {
+				fmt.Printf("ERROR: Failed to deploy cloud resource %s: %v\n", deployReq.Msg.CloudResourceId, err)
+			}
 		}()
 	}
 
@@ -327,6 +357,8 @@ func (s *CloudResourceService) Update(
 }
 
 // Delete deletes a cloud resource by ID.
+// With destroy, its infrastructure is destroyed first and the record is deleted once the destroy succeeds.
+// Without it, the record is only deleted when no deployed resources remain.
 func (s *CloudResourceService) Delete(
 	ctx context.Context,
 	req *connect.Request[cloudresourcev1.DeleteCloudResourceRequest],
@@ -344,6 +376,45 @@ func (s *CloudResourceService) Delete(
 	if resource == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud resource with ID '%s' not found", id))
 	}
+	if err := auth.Authorize(ctx, auth.RoleDeployer, resource.Org, resource.Env); err != nil {
+		return nil, err
+	}
+
+	if s.stackUpdateService != nil {
+		running, err := s.stackUpdateService.hasRunningStackUpdate(ctx, id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check stack-updates: %w", err))
+		}
+		if running {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("cloud resource '%s' has a stack-update in progress", resource.Name))
+		}
+
+		// Destroy the infrastructure first and delete the record once the destroy succeeded
+		if req.Msg.Destroy {
+			stackUpdate, err := s.stackUpdateService.startStackUpdate(ctx, id, "", operationDestroy, func(ctx context.Context) {
+				if err := s.repo.Delete(ctx, id); err != nil {
+					fmt.Printf("ERROR: Failed to delete cloud resource %s after destroy: %v\n", id, err)
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&cloudresourcev1.DeleteCloudResourceResponse{
+				Message:       fmt.Sprintf("Cloud resource '%s' is being destroyed and will be deleted once the destroy succeeds", resource.Name),
+				StackUpdateId: stackUpdate.ID.Hex(),
+			}), nil
+		}
+
+		deployed, err := s.stackUpdateService.hasDeployedResources(ctx, id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check stack-updates: %w", err))
+		}
+		if deployed {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("cloud resource '%s' still has deployed resources; destroy them first or delete with destroy", resource.Name))
+		}
+	}
 
 	// Delete from database
 	err = s.repo.Delete(ctx, id)
@@ -389,11 +460,21 @@ func (s *CloudResourceService) Apply(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("manifest must contain 'metadata.name' field"))
 	}
 
+	org, env := manifestScope(metadata)
+	if err := auth.Authorize(ctx, auth.RoleDeployer, org, env); err != nil {
+		return nil, err
+	}
+
 	// Check if resource exists by name and kind
 	existingResource, err := s.repo.FindByNameAndKind(ctx, name, kind)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check for existing cloud resource: %w", err))
 	}
+	if existingResource != nil {
+		if err := auth.Authorize(ctx, auth.RoleDeployer, existingResource.Org, existingResource.Env); err != nil {
+			return nil, err
+		}
+	}
 
 	var resultResource *models.CloudResource
 	var created bool
@@ -404,6 +485,8 @@ func (s *CloudResourceService) Apply(
 		cloudResource := &models.CloudResource{
 			Name:     name,
 			Kind:     kind,
+			Org:      org,
+			Env:      env,
 			Manifest: manifest,
 		}
 
@@ -418,6 +501,8 @@ func (s *CloudResourceService) Apply(
 		cloudResource := &models.CloudResource{
 			Name:     name,
 			Kind:     kind,
+			Org:      org,
+			Env:      env,
 			Manifest: manifest,
 		}
 
@@ -430,7 +515,7 @@ func (s *CloudResourceService) Apply(
 
 	// Trigger Pulumi deployment automatically (credentials will be resolved from database)
 	if s.stackUpdateService != nil {
-		// Create a deployment request (no provider_config needed - will be resolved automatically)
+		// Create a deployment request (the credential is selected from the manifest)
 		deployReq := &connect.Request[stackupdatev1.DeployCloudResourceRequest]{
 			Msg: &stackupdatev1.DeployCloudResourceRequest{
 				CloudResourceId: resultResource.ID.Hex(),
@@ -439,7 +524,9 @@ func (s *CloudResourceService) Apply(
 
 		// Trigger deployment asynchronously (don't wait for it)
 		go func() {
-			_, _ = s.stackUpdateService.DeployCloudResource(context.Background(), deployReq)
+			if _, err := s.stackUpdateService.DeployCloudResource(context.Background(), deployReq); err != nil {
+				fmt.Printf("ERROR: Failed to deploy cloud resource %s: %v\n", deployReq.Msg.CloudResourceId, err)
+			}
 		}()
 	}
 
@@ -469,7 +556,11 @@ func (s *CloudResourceService) Count(
 	ctx context.Context,
 	req *connect.Request[cloudresourcev1.CountCloudResourcesRequest],
 ) (*connect.Response[cloudresourcev1.CountCloudResourcesResponse], error) {
-	opts := &database.CloudResourceListOptions{}
+	scopes, visible := visibleScopes(ctx, auth.RoleViewer)
+	if !visible {
+		return connect.NewResponse(&cloudresourcev1.CountCloudResourcesResponse{}), nil
+	}
+	opts := &database.CloudResourceListOptions{Scopes: scopes}
 	if req.Msg.Kind != "" {
 		kind := req.Msg.Kind
 		opts.Kind = &kind
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	credentialv1 "github.com/plantonhq/openmcf/apis/org/openmcf/app/credential/v1"
+	auth0v1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/auth0"
+	awsv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws"
+	azurev1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/azure"
+	gcpv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// credentialProvider describes how credentials of a provider are stored and used.
+type credentialProvider struct {
+	// cloudResourceProvider is the provider of the cloud resource kinds the credential deploys.
+	// Its lowercase name is the provider stored on credential documents, e.g. "gcp" or "digital_ocean".
+	cloudResourceProvider cloudresourcekind.CloudResourceProvider
+	// configField is the field of the CredentialProviderConfig oneof holding the provider config.
+	configField protoreflect.Name
+	// secretPaths are the dot-separated field paths of the provider config that are redacted by Get.
+	secretPaths []string
+}
+
+// credentialProviders lists every provider a credential can be created for.
+var credentialProviders = map[credentialv1.Credential_CredentialProvider]credentialProvider{
+	credentialv1.Credential_GCP: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_gcp,
+		configField:           "gcp",
+		secretPaths:           []string{"service_account_key_base64"},
+	},
+	credentialv1.Credential_AWS: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_aws,
+		configField:           "aws",
+		secretPaths:           []string{"secret_access_key", "session_token"},
+	},
+	credentialv1.Credential_AZURE: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_azure,
+		configField:           "azure",
+		secretPaths:           []string{"client_secret"},
+	},
+	credentialv1.Credential_AUTH0: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_auth0,
+		configField:           "auth0",
+		secretPaths:           []string{"client_secret"},
+	},
+	credentialv1.Credential_OPEN_FGA: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_open_fga,
+		configField:           "openfga",
+		secretPaths:           []string{"api_token", "client_secret"},
+	},
+	credentialv1.Credential_ATLAS: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_atlas,
+		configField:           "atlas",
+		secretPaths:           []string{"private_key"},
+	},
+	credentialv1.Credential_CIVO: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_civo,
+		configField:           "civo",
+		secretPaths:           []string{"api_token", "object_store_secret_key"},
+	},
+	credentialv1.Credential_CLOUDFLARE: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_cloudflare,
+		configField:           "cloudflare",
+		secretPaths:           []string{"api_token", "api_key", "r2.secret_access_key"},
+	},
+	credentialv1.Credential_CONFLUENT: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_confluent,
+		configField:           "confluent",
+		secretPaths:           []string{"api_secret"},
+	},
+	credentialv1.Credential_DIGITAL_OCEAN: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_digital_ocean,
+		configField:           "digitalocean",
+		secretPaths:           []string{"api_token", "spaces_secret_key"},
+	},
+	credentialv1.Credential_KUBERNETES: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_kubernetes,
+		configField:           "kubernetes",
+		secretPaths:           []string{"gcp_gke.service_account_key_base64", "digital_ocean_doks.kube_config"},
+	},
+	credentialv1.Credential_SNOWFLAKE: {
+		cloudResourceProvider: cloudresourcekind.CloudResourceProvider_snowflake,
+		configField:           "snowflake",
+		secretPaths:           []string{"password"},
+	},
+}
+
+// credentialProviderName returns the provider name stored on credential documents, or "" when unsupported.
+func credentialProviderName(provider credentialv1.Credential_CredentialProvider) string {
+	info, ok := credentialProviders[provider]
+	if !ok {
+		return ""
+	}
+	return providerEnumToString(info.cloudResourceProvider)
+}
+
+// credentialProviderFromName returns the provider of a stored provider name.
+func credentialProviderFromName(name string) credentialv1.Credential_CredentialProvider {
+	for provider, info := range credentialProviders {
+		if providerEnumToString(info.cloudResourceProvider) == name {
+			return provider
+		}
+	}
+	return credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED
+}
+
+// credentialProviderFor returns the credential provider that deploys kinds of a cloud resource provider.
+func credentialProviderFor(provider cloudresourcekind.CloudResourceProvider) (credentialv1.Credential_CredentialProvider, bool) {
+	for credentialProvider, info := range credentialProviders {
+		if info.cloudResourceProvider == provider {
+			return credentialProvider, true
+		}
+	}
+	return credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED, false
+}
+
+// isTypedCredentialProvider reports whether credentials of the provider are stored as individual document fields.
+// Credentials of all other providers are stored as their provider config.
+func isTypedCredentialProvider(provider credentialv1.Credential_CredentialProvider) bool {
+	switch provider {
+	case credentialv1.Credential_GCP, credentialv1.Credential_AWS, credentialv1.Credential_AZURE, credentialv1.Credential_AUTH0:
+		return true
+	default:
+		return false
+	}
+}
+
+// providerConfigMessage returns the provider config set in the oneof field of the provider, or nil.
+func providerConfigMessage(provider credentialv1.Credential_CredentialProvider, config *credentialv1.CredentialProviderConfig) protoreflect.Message {
+	info, ok := credentialProviders[provider]
+	if !ok || config == nil {
+		return nil
+	}
+	configMessage := config.ProtoReflect()
+	field := configMessage.Descriptor().Fields().ByName(info.configField)
+	if field == nil || !configMessage.Has(field) {
+		return nil
+	}
+	return configMessage.Get(field).Message()
+}
+
+// marshalProviderConfig validates the provider config of a credential and returns it as protojson for storage.
+func marshalProviderConfig(provider credentialv1.Credential_CredentialProvider, config *credentialv1.CredentialProviderConfig) (string, error) {
+	configMessage := providerConfigMessage(provider, config)
+	if configMessage == nil {
+		return "", fmt.Errorf("%s provider_config is required", credentialProviders[provider].configField)
+	}
+	if err := protovalidate.Validate(configMessage.Interface()); err != nil {
+		return "", fmt.Errorf("invalid %s provider_config: %w", credentialProviders[provider].configField, err)
+	}
+	data, err := protojson.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal provider_config: %w", err)
+	}
+	return string(data), nil
+}
+
+// providerConfigFromDoc returns the provider config of a credential document as stored, i.e. with the GCP
+// service account key still base64 encoded. Secret fields must have been opened by the repository.
+func providerConfigFromDoc(doc bson.M) (*credentialv1.CredentialProviderConfig, error) {
+	providerName, _ := doc["provider"].(string)
+	str := func(field string) string {
+		value, _ := doc[field].(string)
+		return value
+	}
+
+	switch providerName {
+	case "gcp":
+		return &credentialv1.CredentialProviderConfig{
+			Data: &credentialv1.CredentialProviderConfig_Gcp{
+				Gcp: &gcpv1.GcpProviderConfig{
+					ServiceAccountKeyBase64: str("service_account_key_base64"),
+				},
+			},
+		}, nil
+	case "aws":
+		return &credentialv1.CredentialProviderConfig{
+			Data: &credentialv1.CredentialProviderConfig_Aws{
+				Aws: &awsv1.AwsProviderConfig{
+					AccountId:       str("account_id"),
+					AccessKeyId:     str("access_key_id"),
+					SecretAccessKey: str("secret_access_key"),
+					Region:          str("region"),
+					SessionToken:    str("session_token"),
+				},
+			},
+		}, nil
+	case "azure":
+		return &credentialv1.CredentialProviderConfig{
+			Data: &credentialv1.CredentialProviderConfig_Azure{
+				Azure: &azurev1.AzureProviderConfig{
+					ClientId:       str("client_id"),
+					ClientSecret:   str("client_secret"),
+					TenantId:       str("tenant_id"),
+					SubscriptionId: str("subscription_id"),
+				},
+			},
+		}, nil
+	case "auth0":
+		return &credentialv1.CredentialProviderConfig{
+			Data: &credentialv1.CredentialProviderConfig_Auth0{
+				Auth0: &auth0v1.Auth0ProviderConfig{
+					Domain:       str("domain"),
+					ClientId:     str("client_id"),
+					ClientSecret: str("client_secret"),
+				},
+			},
+		}, nil
+	}
+
+	if credentialProviderFromName(providerName) == credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED {
+		return nil, fmt.Errorf("unsupported provider: %s", providerName)
+	}
+	config := &credentialv1.CredentialProviderConfig{}
+	if err := protojson.Unmarshal([]byte(str("provider_config")), config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s provider_config: %w", providerName, err)
+	}
+	return config, nil
+}
+
+// secretField walks a dot-separated field path of a provider config. It returns nil when a message
+// on the path is not set.
+func secretField(message protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, nil
+		}
+		if i == len(names)-1 {
+			return message, field
+		}
+		if !message.Has(field) {
+			return nil, nil
+		}
+		message = message.Mutable(field).Message()
+	}
+	return nil, nil
+}
+
+// defaultForEnvsFromDoc returns the environments a credential document is the default for.
+func defaultForEnvsFromDoc(doc bson.M) []string {
+	values, _ := doc["default_for_envs"].(bson.A)
+	envs := make([]string, 0, len(values))
+	for _, value := range values {
+		if env, ok := value.(string); ok {
+			envs = append(envs, env)
+		}
+	}
+	return envs
+}
+
+// normalizeEnvs trims environment names and drops empty and duplicate ones.
+func normalizeEnvs(envs []string) []string {
+	normalized := make([]string, 0, len(envs))
+	seen := map[string]bool{}
+	for _, env := range envs {
+		env = strings.TrimSpace(env)
+		if env == "" || seen[env] {
+			continue
+		}
+		seen[env] = true
+		normalized = append(normalized, env)
+	}
+	return normalized
+}
@@ -3,20 +3,22 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"connectrpc.com/connect"
 	credentialv1 "github.com/plantonhq/openmcf/apis/org/openmcf/app/credential/v1"
-	auth0v1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/auth0"
-	awsv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws"
-	azurev1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/azure"
-	gcpv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp"
 	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
-	"github.com/plantonhq/openmcf/app/backend/pkg/models"
 	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisionerlabels"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
 )
 
-// CredentialResolver resolves provider credentials from the database based on provider.
+// CredentialResolver selects the credential a stack-update runs with and resolves its provider config.
 type CredentialResolver struct {
 	credentialRepo *database.CredentialRepository
 }
@@ -28,95 +30,147 @@ func NewCredentialResolver(credentialRepo *database.CredentialRepository) *Crede
 	}
 }
 
-// ResolveProviderConfig resolves provider credentials from the database based on the provider from cloud resource kind.
-// Returns a CredentialProviderConfig proto message that can be used for deployment.
+// SelectCredential selects the credential of the provider of the manifest kind and returns its ID.
+// The first of these that is set wins:
+//  1. credentialID, from the stack-update request
+//  2. the openmcf.org/credential manifest label, naming a credential by name or ID
+//  3. the credential that is the default for metadata.env of the manifest
+//  4. the only credential of the provider
+//
+// No matching credential, or more than one, fails instead of picking one. The returned error is a connect error.
+func (r *CredentialResolver) SelectCredential(ctx context.Context, manifestObject proto.Message, credentialID string) (string, error) {
+	kindName, err := crkreflect.ExtractKindFromProto(manifestObject)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to extract kind from manifest: %w", err))
+	}
+	provider, err := kindCredentialProvider(kindName)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	providerName := credentialProviderName(provider)
+
+	if credentialID != "" {
+		doc, err := r.credentialRepo.FindByID(ctx, credentialID)
+		if err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to get credential '%s': %w", credentialID, err))
+		}
+		if doc == nil {
+			return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("credential with ID '%s' not found", credentialID))
+		}
+		if doc["provider"] != providerName {
+			return "", connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("credential '%s' is a %v credential, but %s is deployed with %s credentials", credentialID, doc["provider"], kindName, providerName))
+		}
+		return credentialID, nil
+	}
+
+	credentials, err := r.credentialRepo.List(ctx, &providerName)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list %s credentials: %w", providerName, err))
+	}
+
+	metadata := metadatareflect.ExtractMetadata(manifestObject)
+	if label := strings.TrimSpace(metadata.GetLabels()[provisionerlabels.CredentialLabelKey]); label != "" {
+		matches := filterCredentials(credentials, func(doc bson.M) bool {
+			return credentialDocID(doc) == label || doc["name"] == label
+		})
+		source := fmt.Sprintf("the %s label %q", provisionerlabels.CredentialLabelKey, label)
+		return selectOne(matches, providerName, source)
+	}
+
+	if env := metadata.GetEnv(); env != "" {
+		matches := filterCredentials(credentials, func(doc bson.M) bool {
+			return slices.Contains(defaultForEnvsFromDoc(doc), env)
+		})
+		if len(matches) > 0 {
+			return selectOne(matches, providerName, fmt.Sprintf("the default for env %q", env))
+		}
+	}
+
+	switch len(credentials) {
+	case 0:
+		return "", connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("no %s credential found. Please create a %s credential first", providerName, providerName))
+	case 1:
+		return credentialDocID(credentials[0]), nil
+	default:
+		return "", connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("%d %s credentials found (%s). Select one with credential_id, the %s label or a default for metadata.env",
+				len(credentials), providerName, credentialNames(credentials), provisionerlabels.CredentialLabelKey))
+	}
+}
+
+// ResolveProviderConfig returns the provider config of a credential selected by SelectCredential.
 func (r *CredentialResolver) ResolveProviderConfig(
 	ctx context.Context,
-	kindName string,
+	credentialID string,
 ) (*credentialv1.CredentialProviderConfig, error) {
-	// Step 1: Get the CloudResourceKind enum from kind name
+	if credentialID == "" {
+		return nil, fmt.Errorf("no credential was selected for the stack-update")
+	}
+	doc, err := r.credentialRepo.FindByID(ctx, credentialID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch credential '%s': %w", credentialID, err)
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("credential with ID '%s' was deleted", credentialID)
+	}
+	return providerConfigFromDoc(doc)
+}
+
+// kindCredentialProvider returns the provider of the credentials a cloud resource kind is deployed with.
+func kindCredentialProvider(kindName string) (credentialv1.Credential_CredentialProvider, error) {
 	kindEnum, err := crkreflect.KindByKindName(kindName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get kind enum for '%s': %w", kindName, err)
+		return credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED, fmt.Errorf("failed to get kind enum for '%s': %w", kindName, err)
 	}
 
-	// Step 2: Get the provider from the kind
 	providerEnum := crkreflect.GetProvider(kindEnum)
 	if providerEnum == cloudresourcekind.CloudResourceProvider_cloud_resource_provider_unspecified {
-		return nil, fmt.Errorf("provider not configured for cloud resource kind '%s'", kindName)
+		return credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED, fmt.Errorf("provider not configured for cloud resource kind '%s'", kindName)
 	}
 
-	// Step 3: Convert provider enum to string (e.g., "aws", "gcp", "azure")
-	providerString := providerEnumToString(providerEnum)
-	if providerString == "" {
-		return nil, fmt.Errorf("unsupported provider: %v", providerEnum)
+	provider, ok := credentialProviderFor(providerEnum)
+	if !ok {
+		return credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED, fmt.Errorf("unsupported provider: %v", providerEnum)
 	}
+	return provider, nil
+}
 
-	// Step 4: Fetch the first credential from the repository based on provider
-	credInterface, err := r.credentialRepo.FindFirstByProvider(ctx, providerString)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch %s credential: %w", providerString, err)
-	}
-	if credInterface == nil {
-		return nil, fmt.Errorf("no %s credential found. Please create a %s credential first", strings.ToUpper(providerString), strings.ToUpper(providerString))
-	}
-
-	// Convert to CredentialProviderConfig based on provider type
-	switch providerString {
-	case "aws":
-		awsCred := credInterface.(*models.AwsCredential)
-		region := awsCred.Region
-		sessionToken := awsCred.SessionToken
-		return &credentialv1.CredentialProviderConfig{
-			Data: &credentialv1.CredentialProviderConfig_Aws{
-				Aws: &awsv1.AwsProviderConfig{
-					AccountId:       awsCred.AccountID,
-					AccessKeyId:     awsCred.AccessKeyID,
-					SecretAccessKey: awsCred.SecretAccessKey,
-					Region:          region,
-					SessionToken:    sessionToken,
-				},
-			},
-		}, nil
-
-	case "gcp":
-		gcpCred := credInterface.(*models.GcpCredential)
-		return &credentialv1.CredentialProviderConfig{
-			Data: &credentialv1.CredentialProviderConfig_Gcp{
-				Gcp: &gcpv1.GcpProviderConfig{
-					ServiceAccountKeyBase64: gcpCred.ServiceAccountKeyBase64,
-				},
-			},
-		}, nil
-
-	case "azure":
-		azureCred := credInterface.(*models.AzureCredential)
-		return &credentialv1.CredentialProviderConfig{
-			Data: &credentialv1.CredentialProviderConfig_Azure{
-				Azure: &azurev1.AzureProviderConfig{
-					ClientId:       azureCred.ClientID,
-					ClientSecret:   azureCred.ClientSecret,
-					TenantId:       azureCred.TenantID,
-					SubscriptionId: azureCred.SubscriptionID,
-				},
-			},
-		}, nil
-
-	case "auth0":
-		auth0Cred := credInterface.(*models.Auth0Credential)
-		return &credentialv1.CredentialProviderConfig{
-			Data: &credentialv1.CredentialProviderConfig_Auth0{
-				Auth0: &auth0v1.Auth0ProviderConfig{
-					Domain:       auth0Cred.Domain,
-					ClientId:     auth0Cred.ClientID,
-					ClientSecret: auth0Cred.ClientSecret,
-				},
-			},
-		}, nil
-
+// selectOne returns the ID of the only credential in matches, which were selected by source.
+func selectOne(matches []bson.M, providerName, source string) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no %s credential matches %s", providerName, source))
+	case 1:
+		return credentialDocID(matches[0]), nil
 	default:
-		return nil, fmt.Errorf("provider '%s' is not yet supported for automatic credential resolution", providerString)
+		return "", connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("%d %s credentials (%s) match %s", len(matches), providerName, credentialNames(matches), source))
+	}
+}
+
+func filterCredentials(credentials []bson.M, match func(doc bson.M) bool) []bson.M {
+	var matches []bson.M
+	for _, doc := range credentials {
+		if match(doc) {
+			matches = append(matches, doc)
+		}
+	}
+	return matches
+}
+
+func credentialDocID(doc bson.M) string {
+	id, _ := doc["_id"].(primitive.ObjectID)
+	return id.Hex()
+}
+
+func credentialNames(credentials []bson.M) string {
+	names := make([]string, 0, len(credentials))
+	for _, doc := range credentials {
+		names = append(names, fmt.Sprintf("%v", doc["name"]))
 	}
+	return strings.Join(names, ", ")
 }
 
 // providerEnumToString converts CloudResourceProvider enum to a lowercase string.
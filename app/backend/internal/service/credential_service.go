@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
 	"github.com/plantonhq/openmcf/app/backend/pkg/models"
 
@@ -18,9 +19,13 @@ import (
 	gcpv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// redactedSecret replaces secret fields in credentials returned by Get unless reveal_secrets is set.
+const redactedSecret = "********"
+
 // CredentialService implements the CredentialService RPC.
 type CredentialService struct {
 	credentialRepo *database.CredentialRepository
@@ -38,6 +43,10 @@ func (s *CredentialService) Create(
 	ctx context.Context,
 	req *connect.Request[credentialv1.CreateCredentialRequest],
 ) (*connect.Response[credentialv1.CreateCredentialResponse], error) {
+	if err := auth.AuthorizeUnscoped(ctx, auth.RoleCredentialAdmin); err != nil {
+		return nil, err
+	}
+
 	// Validate common fields
 	if req.Msg.Name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
@@ -46,21 +55,81 @@ func (s *CredentialService) Create(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider is required"))
 	}
 
+	providerName := credentialProviderName(req.Msg.Provider)
+	if providerName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %v", req.Msg.Provider))
+	}
+
+	// An environment has at most one default credential per provider, so deploys never have to guess
+	defaultForEnvs := normalizeEnvs(req.Msg.DefaultForEnvs)
+	if err := s.credentialRepo.CheckDefaultForEnvs(ctx, providerName, "", defaultForEnvs); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
 	now := time.Now()
 
 	// Handle based on provider type
+	var resp *connect.Response[credentialv1.CreateCredentialResponse]
+	var err error
 	switch req.Msg.Provider {
 	case credentialv1.Credential_GCP:
-		return s.createGcpCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
+		resp, err = s.createGcpCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
 	case credentialv1.Credential_AWS:
-		return s.createAwsCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
+		resp, err = s.createAwsCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
 	case credentialv1.Credential_AZURE:
-		return s.createAzureCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
+		resp, err = s.createAzureCredential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
 	case credentialv1.Credential_AUTH0:
-		return s.createAuth0Credential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
+		resp, err = s.createAuth0Credential(ctx, req.Msg.Name, req.Msg.ProviderConfig, now)
 	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %v", req.Msg.Provider))
+		resp, err = s.createProviderConfigCredential(ctx, req.Msg.Name, req.Msg.Provider, req.Msg.ProviderConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(defaultForEnvs) > 0 {
+		if err := s.credentialRepo.SetDefaultForEnvs(ctx, resp.Msg.Credential.Id, defaultForEnvs); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set default environments: %w", err))
+		}
+	}
+	resp.Msg.Credential.DefaultForEnvs = defaultForEnvs
+	return resp, nil
+}
+
+// createProviderConfigCredential creates a credential of a provider stored as its provider config.
+func (s *CredentialService) createProviderConfigCredential(
+	ctx context.Context,
+	name string,
+	provider credentialv1.Credential_CredentialProvider,
+	providerConfig *credentialv1.CredentialProviderConfig,
+) (*connect.Response[credentialv1.CreateCredentialResponse], error) {
+	configJSON, err := marshalProviderConfig(provider, providerConfig)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	createdCredential, err := s.credentialRepo.CreateWithConfig(ctx, name, credentialProviderName(provider), configJSON)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create credential: %w", err))
+	}
+
+	protoCredential := &credentialv1.Credential{
+		Id:             createdCredential.ID.Hex(),
+		Name:           createdCredential.Name,
+		Provider:       provider,
+		ProviderConfig: providerConfig,
+	}
+
+	if !createdCredential.CreatedAt.IsZero() {
+		protoCredential.CreatedAt = timestamppb.New(createdCredential.CreatedAt)
+	}
+	if !createdCredential.UpdatedAt.IsZero() {
+		protoCredential.UpdatedAt = timestamppb.New(createdCredential.UpdatedAt)
 	}
+
+	return connect.NewResponse(&credentialv1.CreateCredentialResponse{
+		Credential: protoCredential,
+	}), nil
 }
 
 // createGcpCredential creates a GCP credential.
@@ -310,24 +379,18 @@ func (s *CredentialService) List(
 	ctx context.Context,
 	req *connect.Request[credentialv1.ListCredentialsRequest],
 ) (*connect.Response[credentialv1.ListCredentialsResponse], error) {
+	if err := auth.AuthorizeUnscoped(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	// Convert provider enum to string for database query
 	var providerFilter *string
 	if req.Msg.Provider != credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED {
-		// Convert CredentialProvider enum to string
-		var provider string
-		switch req.Msg.Provider {
-		case credentialv1.Credential_GCP:
-			provider = "gcp"
-		case credentialv1.Credential_AWS:
-			provider = "aws"
-		case credentialv1.Credential_AZURE:
-			provider = "azure"
-		case credentialv1.Credential_AUTH0:
-			provider = "auth0"
-		}
-		if provider != "" {
-			providerFilter = &provider
+		provider := credentialProviderName(req.Msg.Provider)
+		if provider == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %v", req.Msg.Provider))
 		}
+		providerFilter = &provider
 	}
 
 	// Query database
@@ -340,21 +403,10 @@ func (s *CredentialService) List(
 	summaries := make([]*credentialv1.CredentialSummary, 0, len(credentials))
 	for _, cred := range credentials {
 		summary := &credentialv1.CredentialSummary{
-			Id:   cred["_id"].(primitive.ObjectID).Hex(),
-			Name: cred["name"].(string),
-		}
-
-		// Convert provider string to enum
-		providerStr := cred["provider"].(string)
-		switch providerStr {
-		case "gcp":
-			summary.Provider = credentialv1.Credential_GCP
-		case "aws":
-			summary.Provider = credentialv1.Credential_AWS
-		case "azure":
-			summary.Provider = credentialv1.Credential_AZURE
-		case "auth0":
-			summary.Provider = credentialv1.Credential_AUTH0
+			Id:             cred["_id"].(primitive.ObjectID).Hex(),
+			Name:           cred["name"].(string),
+			Provider:       credentialProviderFromName(cred["provider"].(string)),
+			DefaultForEnvs: defaultForEnvsFromDoc(cred),
 		}
 
 		// Add timestamps if present
@@ -378,6 +430,14 @@ func (s *CredentialService) Get(
 	ctx context.Context,
 	req *connect.Request[credentialv1.GetCredentialRequest],
 ) (*connect.Response[credentialv1.GetCredentialResponse], error) {
+	role := auth.RoleViewer
+	if req.Msg.RevealSecrets {
+		role = auth.RoleCredentialAdmin
+	}
+	if err := auth.AuthorizeUnscoped(ctx, role); err != nil {
+		return nil, err
+	}
+
 	if req.Msg.Id == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
 	}
@@ -513,7 +573,31 @@ func (s *CredentialService) Get(
 			protoCredential.UpdatedAt = timestamppb.New(auth0Cred.UpdatedAt)
 		}
 	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %s", providerStr))
+		provider := credentialProviderFromName(providerStr)
+		if provider == credentialv1.Credential_CREDENTIAL_PROVIDER_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %s", providerStr))
+		}
+		providerConfig, err := providerConfigFromDoc(doc)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert credential: %w", err))
+		}
+		protoCredential = &credentialv1.Credential{
+			Id:             doc["_id"].(primitive.ObjectID).Hex(),
+			Name:           doc["name"].(string),
+			Provider:       provider,
+			ProviderConfig: providerConfig,
+		}
+		if createdAt, ok := doc["created_at"].(primitive.DateTime); ok {
+			protoCredential.CreatedAt = timestamppb.New(createdAt.Time())
+		}
+		if updatedAt, ok := doc["updated_at"].(primitive.DateTime); ok {
+			protoCredential.UpdatedAt = timestamppb.New(updatedAt.Time())
+		}
+	}
+	protoCredential.DefaultForEnvs = defaultForEnvsFromDoc(doc)
+
+	if !req.Msg.RevealSecrets {
+		redactCredentialSecrets(protoCredential)
 	}
 
 	return connect.NewResponse(&credentialv1.GetCredentialResponse{
@@ -526,6 +610,10 @@ func (s *CredentialService) Update(
 	ctx context.Context,
 	req *connect.Request[credentialv1.UpdateCredentialRequest],
 ) (*connect.Response[credentialv1.UpdateCredentialResponse], error) {
+	if err := auth.AuthorizeUnscoped(ctx, auth.RoleCredentialAdmin); err != nil {
+		return nil, err
+	}
+
 	if req.Msg.Id == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
 	}
@@ -536,21 +624,150 @@ func (s *CredentialService) Update(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider is required"))
 	}
 
+	providerName := credentialProviderName(req.Msg.Provider)
+	if providerName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %v", req.Msg.Provider))
+	}
+	defaultForEnvs := normalizeEnvs(req.Msg.DefaultForEnvs)
+	if err := s.credentialRepo.CheckDefaultForEnvs(ctx, providerName, req.Msg.Id, defaultForEnvs); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	// Secrets left as the redaction placeholder keep their stored value
+	if err := s.restoreRedactedSecrets(ctx, req.Msg.Id, req.Msg.Provider, req.Msg.ProviderConfig); err != nil {
+		return nil, err
+	}
+
 	// Handle based on provider type
+	var resp *connect.Response[credentialv1.UpdateCredentialResponse]
+	var err error
 	switch req.Msg.Provider {
 	case credentialv1.Credential_GCP:
-		return s.updateGcpCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
+		resp, err = s.updateGcpCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
 	case credentialv1.Credential_AWS:
-		return s.updateAwsCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
+		resp, err = s.updateAwsCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
 	case credentialv1.Credential_AZURE:
-		return s.updateAzureCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
+		resp, err = s.updateAzureCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
 	case credentialv1.Credential_AUTH0:
-		return s.updateAuth0Credential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
+		resp, err = s.updateAuth0Credential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.ProviderConfig)
 	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported provider: %v", req.Msg.Provider))
+		resp, err = s.updateProviderConfigCredential(ctx, req.Msg.Id, req.Msg.Name, req.Msg.Provider, req.Msg.ProviderConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.credentialRepo.SetDefaultForEnvs(ctx, req.Msg.Id, defaultForEnvs); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set default environments: %w", err))
+	}
+	resp.Msg.Credential.DefaultForEnvs = defaultForEnvs
+
+	// Restored secrets must not be echoed back to the caller
+	redactCredentialSecrets(resp.Msg.Credential)
+	return resp, nil
+}
+
+// restoreRedactedSecrets replaces secret fields set to the redaction placeholder with the stored values,
+// so a credential returned by Get can be sent back to Update without revealing its secrets.
+func (s *CredentialService) restoreRedactedSecrets(
+	ctx context.Context,
+	id string,
+	provider credentialv1.Credential_CredentialProvider,
+	providerConfig *credentialv1.CredentialProviderConfig,
+) error {
+	configMessage := providerConfigMessage(provider, providerConfig)
+	if configMessage == nil {
+		return nil
+	}
+
+	var stored protoreflect.Message
+	for _, path := range credentialProviders[provider].secretPaths {
+		message, field := secretField(configMessage, path)
+		if message == nil || message.Get(field).String() != redactedSecret {
+			continue
+		}
+		if stored == nil {
+			doc, err := s.credentialRepo.FindByID(ctx, id)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get credential: %w", err))
+			}
+			if doc == nil {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("credential with ID '%s' not found", id))
+			}
+			storedConfig, err := providerConfigFromDoc(doc)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to convert credential: %w", err))
+			}
+			// A credential of another provider has no stored secrets to restore
+			if stored = providerConfigMessage(provider, storedConfig); stored == nil {
+				stored = configMessage.Type().New()
+			}
+		}
+		value := ""
+		if storedMessage, storedField := secretField(stored, path); storedMessage != nil {
+			value = storedMessage.Get(storedField).String()
+		}
+		message.Set(field, protoreflect.ValueOfString(value))
+	}
+	return nil
+}
+
+// redactCredentialSecrets replaces the non-empty secret fields of a credential with the redaction placeholder.
+func redactCredentialSecrets(credential *credentialv1.Credential) {
+	if credential == nil {
+		return
+	}
+	configMessage := providerConfigMessage(credential.Provider, credential.ProviderConfig)
+	if configMessage == nil {
+		return
+	}
+	for _, path := range credentialProviders[credential.Provider].secretPaths {
+		if message, field := secretField(configMessage, path); message != nil && message.Get(field).String() != "" {
+			message.Set(field, protoreflect.ValueOfString(redactedSecret))
+		}
 	}
 }
 
+// updateProviderConfigCredential updates a credential of a provider stored as its provider config.
+func (s *CredentialService) updateProviderConfigCredential(
+	ctx context.Context,
+	id, name string,
+	provider credentialv1.Credential_CredentialProvider,
+	providerConfig *credentialv1.CredentialProviderConfig,
+) (*connect.Response[credentialv1.UpdateCredentialResponse], error) {
+	configJSON, err := marshalProviderConfig(provider, providerConfig)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	providerName := credentialProviderName(provider)
+	updatedCredential, err := s.credentialRepo.UpdateWithConfig(ctx, id, name, providerName, configJSON)
+	if err != nil {
+		if err.Error() == fmt.Sprintf("%s credential with ID '%s' not found", providerName, id) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update credential: %w", err))
+	}
+
+	protoCredential := &credentialv1.Credential{
+		Id:             updatedCredential.ID.Hex(),
+		Name:           updatedCredential.Name,
+		Provider:       provider,
+		ProviderConfig: providerConfig,
+	}
+
+	if !updatedCredential.CreatedAt.IsZero() {
+		protoCredential.CreatedAt = timestamppb.New(updatedCredential.CreatedAt)
+	}
+	if !updatedCredential.UpdatedAt.IsZero() {
+		protoCredential.UpdatedAt = timestamppb.New(updatedCredential.UpdatedAt)
+	}
+
+	return connect.NewResponse(&credentialv1.UpdateCredentialResponse{
+		Credential: protoCredential,
+	}), nil
+}
+
 // updateGcpCredential updates a GCP credential.
 func (s *CredentialService) updateGcpCredential(
 	ctx context.Context,
@@ -803,6 +1020,10 @@ func (s *CredentialService) Delete(
 	ctx context.Context,
 	req *connect.Request[credentialv1.DeleteCredentialRequest],
 ) (*connect.Response[credentialv1.DeleteCredentialResponse], error) {
+	if err := auth.AuthorizeUnscoped(ctx, auth.RoleCredentialAdmin); err != nil {
+		return nil, err
+	}
+
 	if req.Msg.Id == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
 	}
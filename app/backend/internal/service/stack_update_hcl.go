@@ -0,0 +1,282 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
+	"github.com/plantonhq/openmcf/app/backend/internal/database"
+	"github.com/plantonhq/openmcf/app/backend/pkg/models"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	"github.com/plantonhq/openmcf/pkg/iac/stackinput/stackinputproviderconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tofumodule"
+	"github.com/plantonhq/openmcf/pkg/kubernetes/kubecontext"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// tofuModuleDirEnv optionally points at a local tofu/terraform module directory to use instead of
+	// the released module of the manifest kind.
+	tofuModuleDirEnv = "TOFU_MODULE_DIR"
+	// tofuStateDirEnv is the directory for local tofu/terraform state files, one per cloud resource.
+	tofuStateDirEnv = "TOFU_STATE_DIR"
+)
+
+// runHcl executes the tofu or terraform operation (apply, destroy, plan or refresh) of the stack-update
+// and stores output in stackupdates table.
+// The module is staged and initialized by tofumodule, as in the CLI:
+// 1. Loads the manifest and resolves the kind and provider
+// 2. Builds the state backend from PROJECT_PLANTON_BACKEND_* variables and manifest labels
+// 3. Resolves the provider config of the credential selected for the stack-update
+// 4. Runs init and the operation with -json and stores every log event as a streaming response
+func (s *StackUpdateService) runHcl(ctx context.Context, stackUpdateID, cloudResourceID, credentialID, manifestYaml string,
+	binary provisioner.HclBinary, operation string) error {
+	if err := binary.CheckAvailable(); err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, err)
+	}
+
+	manifestPath, err := writeManifestTempFile(manifestYaml)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, err)
+	}
+	defer os.Remove(manifestPath)
+
+	manifestObject, err := manifest.LoadManifest(manifestPath)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to load manifest: %w", err))
+	}
+
+	kindName, err := crkreflect.ExtractKindFromProto(manifestObject)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to extract kind from manifest: %w", err))
+	}
+	kindEnum, err := crkreflect.KindByKindName(kindName)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to get kind enum for '%s': %w", kindName, err))
+	}
+	provider := crkreflect.GetProvider(kindEnum)
+
+	backendConfig, err := hclBackendConfig(manifestObject, binary, cloudResourceID)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, err)
+	}
+
+	credentialConfig, err := s.credentialResolver.ResolveProviderConfig(ctx, credentialID)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to resolve provider credentials: %w", err))
+	}
+	providerConfig, cleanupProviderConfig, err := stackinputproviderconfig.BuildFromProto(extractCredentialProto(credentialConfig), provider)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to build provider config from user credentials: %w", err))
+	}
+	defer cleanupProviderConfig()
+
+	if err := stackinputproviderconfig.ValidateProviderConfig(provider, providerConfig, kindName); err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, err)
+	}
+
+	// Store log events as they arrive so StreamStackUpdateOutput can follow the run
+	recorder := &hclEventRecorder{repo: s.streamingResponseRepo, stackUpdateID: stackUpdateID}
+	events := make(chan string)
+	recorded := make(chan struct{})
+	go func() {
+		defer close(recorded)
+		for line := range events {
+			recorder.record(context.Background(), line)
+		}
+	}()
+
+	runErr := tofumodule.RunCommandWithEvents(
+		binary.String(),
+		os.Getenv(tofuModuleDirEnv),
+		manifestPath,
+		hclOperation(operation),
+		nil,
+		true,
+		false,
+		false,
+		"",
+		false,
+		kubecontext.ExtractFromManifest(manifestObject),
+		providerConfig,
+		backendConfig,
+		events,
+		nil,
+	)
+	close(events)
+	<-recorded
+
+	deploymentOutput := map[string]interface{}{
+		"timestamp":    time.Now().Format(time.RFC3339),
+		"error_type":   binary.String(),
+		"backend_type": backendConfig.BackendType,
+		"stdout":       recorder.stdout.String(),
+		"stderr":       recorder.stderr.String(),
+	}
+
+	status := "success"
+	if runErr != nil {
+		status = "failed"
+		errorMsg := recorder.stderr.String()
+		if errorMsg == "" {
+			errorMsg = runErr.Error()
+		}
+		deploymentOutput["error"] = errorMsg
+	}
+	deploymentOutput["status"] = status
+
+	outputJSON, err := json.Marshal(deploymentOutput)
+	if err != nil {
+		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to marshal deployment output: %w", err))
+	}
+
+	if _, err := s.stackUpdateRepo.Update(ctx, stackUpdateID, &models.StackUpdate{
+		Status: status,
+		Output: string(outputJSON),
+	}); err != nil {
+		return fmt.Errorf("failed to update stack-update: %w", err)
+	}
+	return nil
+}
+
+// hclOperation returns the tofu/terraform operation that runs the stack-update operation.
+func hclOperation(operation string) terraform.TerraformOperationType {
+	switch operation {
+	case operationDestroy:
+		return terraform.TerraformOperationType_destroy
+	case operationPreview:
+		return terraform.TerraformOperationType_plan
+	case operationRefresh:
+		return terraform.TerraformOperationType_refresh
+	default:
+		return terraform.TerraformOperationType_apply
+	}
+}
+
+// hclBackendConfig builds the state backend for a tofu or terraform stack-update the same way the CLI
+// does, from the PROJECT_PLANTON_BACKEND_* environment variables and the manifest labels.
+// Without a remote backend, state goes to a local file per cloud resource under TOFU_STATE_DIR
+// so it outlives the staged module.
+func hclBackendConfig(manifestObject proto.Message, binary provisioner.HclBinary,
+	cloudResourceID string) (*backendconfig.TofuBackendConfig, error) {
+	config, err := backendconfig.BuildBackendConfig(manifestObject, binary.String(), backendconfig.CLIBackendFlags{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build backend configuration: %w", err)
+	}
+
+	if config.BackendType == "" || config.BackendType == "local" {
+		stateDir, err := tofuStateDir()
+		if err != nil {
+			return nil, err
+		}
+		config.BackendType = "local"
+		if config.BackendKey == "" {
+			config.BackendKey = cloudResourceID + ".tfstate"
+		}
+		if !filepath.IsAbs(config.BackendKey) {
+			config.BackendKey = filepath.Join(stateDir, config.BackendKey)
+		}
+		return config, nil
+	}
+
+	validation := backendconfig.Validate(config)
+	if !validation.Valid {
+		missing := make([]string, 0, len(validation.MissingFields))
+		for _, field := range validation.MissingFields {
+			missing = append(missing, fmt.Sprintf("%s (%s)", field.Name, field.Description))
+		}
+		return nil, fmt.Errorf("incomplete %s backend configuration, missing: %s",
+			config.BackendType, strings.Join(missing, ", "))
+	}
+	return config, nil
+}
+
+// tofuStateDir returns the directory for local state files, creating it if needed.
+// It defaults to ~/.openmcf/tofu-state when TOFU_STATE_DIR is not set.
+func tofuStateDir() (string, error) {
+	stateDir := os.Getenv(tofuStateDirEnv)
+	if stateDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to get home directory: %w", err)
+		}
+		stateDir = filepath.Join(homeDir, ".openmcf", "tofu-state")
+	}
+	if err := os.MkdirAll(stateDir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create state directory %s: %w", stateDir, err)
+	}
+	return stateDir, nil
+}
+
+// hclLogEvent is the part of a tofu/terraform -json log line that is kept for the stack-update.
+type hclLogEvent struct {
+	Level      string `json:"@level"`
+	Message    string `json:"@message"`
+	Diagnostic *struct {
+		Detail string `json:"detail"`
+	} `json:"diagnostic"`
+}
+
+// hclEventRecorder stores tofu/terraform -json log lines as streaming responses and keeps
+// their messages for the final stack-update output.
+type hclEventRecorder struct {
+	repo          *database.StackUpdateStreamingResponseRepository
+	stackUpdateID string
+	sequenceNum   int
+	stdout        bytes.Buffer
+	stderr        bytes.Buffer
+}
+
+// record stores one log line. Error events go to the stderr stream, everything else to stdout.
+func (r *hclEventRecorder) record(ctx context.Context, line string) {
+	content, streamType := hclEventContent(line)
+	if strings.TrimSpace(content) == "" {
+		return
+	}
+
+	buffer := &r.stdout
+	if streamType == "stderr" {
+		buffer = &r.stderr
+	}
+	buffer.WriteString(content)
+	buffer.WriteString("\n")
+
+	streamingResponse := &models.StackUpdateStreamingResponse{
+		StackUpdateID: r.stackUpdateID,
+		Content:       content,
+		StreamType:    streamType,
+		SequenceNum:   r.sequenceNum,
+	}
+	r.sequenceNum++
+	if _, err := r.repo.Create(ctx, streamingResponse); err != nil {
+		fmt.Printf("ERROR: Failed to store streaming response (seq=%d, type=%s, stackUpdateID=%s): %v\n",
+			streamingResponse.SequenceNum, streamType, r.stackUpdateID, err)
+	}
+}
+
+// hclEventContent returns the human-readable message of a -json log line and its stream type.
+// Diagnostics include their detail. Lines that are not JSON log events are kept as they are.
+func hclEventContent(line string) (string, string) {
+	var event hclLogEvent
+	if err := json.Unmarshal([]byte(line), &event); err != nil || event.Message == "" {
+		return line, "stdout"
+	}
+
+	content := event.Message
+	if event.Diagnostic != nil && event.Diagnostic.Detail != "" {
+		content += "\n" + event.Diagnostic.Detail
+	}
+	if event.Level == "error" {
+		return content, "stderr"
+	}
+	return content, "stdout"
+}
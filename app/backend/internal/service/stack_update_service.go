@@ -16,10 +16,12 @@ import (
 	"connectrpc.com/connect"
 	credentialv1 "github.com/plantonhq/openmcf/apis/org/openmcf/app/credential/v1"
 	stackupdatev1 "github.com/plantonhq/openmcf/apis/org/openmcf/app/stackupdate/v1"
+	"github.com/plantonhq/openmcf/app/backend/internal/auth"
 	"github.com/plantonhq/openmcf/app/backend/internal/database"
 	"github.com/plantonhq/openmcf/app/backend/pkg/models"
 	"github.com/plantonhq/openmcf/internal/manifest"
 	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
 	"github.com/plantonhq/openmcf/pkg/iac/pulumi/backendconfig"
 	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumimodule"
 	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumistack"
@@ -52,13 +54,84 @@ func NewStackUpdateService(
 	}
 }
 
-// DeployCloudResource deploys a cloud resource using Pulumi.
-// Fetches the manifest from the cloud resource ID, executes pulumi up, and stores the result in stackupdates table.
+// Stack-update operations, stored on each stack-update.
+const (
+	operationDeploy  = "deploy"
+	operationDestroy = "destroy"
+	operationPreview = "preview"
+	operationRefresh = "refresh"
+)
+
+// DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+// Fetches the manifest from the cloud resource ID, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
 func (s *StackUpdateService) DeployCloudResource(
 	ctx context.Context,
 	req *connect.Request[stackupdatev1.DeployCloudResourceRequest],
 ) (*connect.Response[stackupdatev1.DeployCloudResourceResponse], error) {
-	cloudResourceID := req.Msg.CloudResourceId
+	stackUpdate, err := s.startStackUpdate(ctx, req.Msg.CloudResourceId, req.Msg.CredentialId, operationDeploy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stackupdatev1.DeployCloudResourceResponse{
+		StackUpdate: toProtoStackUpdate(stackUpdate),
+	}), nil
+}
+
+// DestroyCloudResource destroys the infrastructure of a cloud resource.
+// Executes pulumi destroy or tofu/terraform destroy. The cloud resource record is kept.
+func (s *StackUpdateService) DestroyCloudResource(
+	ctx context.Context,
+	req *connect.Request[stackupdatev1.DestroyCloudResourceRequest],
+) (*connect.Response[stackupdatev1.DestroyCloudResourceResponse], error) {
+	stackUpdate, err := s.startStackUpdate(ctx, req.Msg.CloudResourceId, req.Msg.CredentialId, operationDestroy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stackupdatev1.DestroyCloudResourceResponse{
+		StackUpdate: toProtoStackUpdate(stackUpdate),
+	}), nil
+}
+
+// PreviewCloudResource previews the changes a deploy would make without applying them.
+// Executes pulumi preview or tofu/terraform plan.
+func (s *StackUpdateService) PreviewCloudResource(
+	ctx context.Context,
+	req *connect.Request[stackupdatev1.PreviewCloudResourceRequest],
+) (*connect.Response[stackupdatev1.PreviewCloudResourceResponse], error) {
+	stackUpdate, err := s.startStackUpdate(ctx, req.Msg.CloudResourceId, req.Msg.CredentialId, operationPreview, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stackupdatev1.PreviewCloudResourceResponse{
+		StackUpdate: toProtoStackUpdate(stackUpdate),
+	}), nil
+}
+
+// RefreshCloudResource syncs the stack state with the real infrastructure.
+// Executes pulumi refresh or tofu/terraform refresh.
+func (s *StackUpdateService) RefreshCloudResource(
+	ctx context.Context,
+	req *connect.Request[stackupdatev1.RefreshCloudResourceRequest],
+) (*connect.Response[stackupdatev1.RefreshCloudResourceResponse], error) {
+	stackUpdate, err := s.startStackUpdate(ctx, req.Msg.CloudResourceId, req.Msg.CredentialId, operationRefresh, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stackupdatev1.RefreshCloudResourceResponse{
+		StackUpdate: toProtoStackUpdate(stackUpdate),
+	}), nil
+}
+
+// startStackUpdate creates an in_progress stack-update for the operation and runs it asynchronously
+// with the provisioner named in the manifest labels. credentialID, if set, names the credential to run with;
+// otherwise it is selected from the manifest. onSuccess, if set, runs after the stack-update succeeded.
+// Returned errors are connect errors.
+func (s *StackUpdateService) startStackUpdate(ctx context.Context, cloudResourceID, credentialID, operation string,
+	onSuccess func(ctx context.Context)) (*models.StackUpdate, error) {
 	if cloudResourceID == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cloud_resource_id cannot be empty"))
 	}
@@ -72,11 +145,37 @@ func (s *StackUpdateService) DeployCloudResource(
 	if cloudResource == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cloud resource with ID '%s' not found", cloudResourceID))
 	}
+	if err := auth.Authorize(ctx, auth.RoleDeployer, cloudResource.Org, cloudResource.Env); err != nil {
+		return nil, err
+	}
+
+	manifestObject, err := loadManifestObject(cloudResource.Manifest)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Route on the provisioner label so tofu and terraform manifests don't run through Pulumi
+	provisionerType, err := manifestProvisioner(manifestObject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Select the credential up front, so a missing or ambiguous credential fails the request
+	// instead of a stack-update
+	credentialID, err = s.credentialResolver.SelectCredential(ctx, manifestObject, credentialID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create stack-update with in_progress status
 	stackUpdate := &models.StackUpdate{
 		CloudResourceID: cloudResourceID,
+		Org:             cloudResource.Org,
+		Env:             cloudResource.Env,
 		Status:          "in_progress",
+		Provisioner:     provisionerType.String(),
+		Operation:       operation,
+		CredentialID:    credentialID,
 	}
 
 	createdStackUpdate, err := s.stackUpdateRepo.Create(ctx, stackUpdate)
@@ -84,31 +183,64 @@ func (s *StackUpdateService) DeployCloudResource(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create stack-update: %w", err))
 	}
 
-	// Execute Pulumi deployment asynchronously
-	// Credentials will be resolved automatically from database during deployment
+	// Execute the operation asynchronously with the selected credential
 	stackUpdateID := createdStackUpdate.ID.Hex()
 	go func() {
-		_ = s.deployWithPulumi(context.Background(), stackUpdateID, cloudResourceID, cloudResource.Manifest)
+		runCtx := context.Background()
+		var runErr error
+		if binary := provisioner.HclBinaryFromProvisionerType(provisionerType); binary != "" {
+			runErr = s.runHcl(runCtx, stackUpdateID, cloudResourceID, credentialID, cloudResource.Manifest, binary, operation)
+		} else {
+			runErr = s.runPulumi(runCtx, stackUpdateID, cloudResourceID, credentialID, cloudResource.Manifest, operation)
+		}
+		if runErr != nil || onSuccess == nil {
+			return
+		}
+		finished, err := s.stackUpdateRepo.FindByID(runCtx, stackUpdateID)
+		if err != nil || finished == nil || finished.Status != "success" {
+			return
+		}
+		onSuccess(runCtx)
 	}()
 
-	// Convert to proto
-	protoStackUpdate := &stackupdatev1.StackUpdate{
-		Id:              createdStackUpdate.ID.Hex(),
-		CloudResourceId: createdStackUpdate.CloudResourceID,
-		Status:          createdStackUpdate.Status,
-		Output:          createdStackUpdate.Output,
-	}
+	return createdStackUpdate, nil
+}
 
-	if !createdStackUpdate.CreatedAt.IsZero() {
-		protoStackUpdate.CreatedAt = timestamppb.New(createdStackUpdate.CreatedAt)
+// hasRunningStackUpdate reports whether a stack-update of the cloud resource is still in progress.
+func (s *StackUpdateService) hasRunningStackUpdate(ctx context.Context, cloudResourceID string) (bool, error) {
+	stackUpdates, err := s.stackUpdateRepo.FindByCloudResourceID(ctx, cloudResourceID)
+	if err != nil {
+		return false, err
 	}
-	if !createdStackUpdate.UpdatedAt.IsZero() {
-		protoStackUpdate.UpdatedAt = timestamppb.New(createdStackUpdate.UpdatedAt)
+	for _, stackUpdate := range stackUpdates {
+		if stackUpdate.Status == "in_progress" {
+			return true, nil
+		}
 	}
+	return false, nil
+}
 
-	return connect.NewResponse(&stackupdatev1.DeployCloudResourceResponse{
-		StackUpdate: protoStackUpdate,
-	}), nil
+// hasDeployedResources reports whether the cloud resource still has infrastructure, i.e. its latest
+// successful deploy was not followed by a successful destroy. Stack-updates created before
+// operations were recorded are deploys.
+func (s *StackUpdateService) hasDeployedResources(ctx context.Context, cloudResourceID string) (bool, error) {
+	stackUpdates, err := s.stackUpdateRepo.FindByCloudResourceID(ctx, cloudResourceID)
+	if err != nil {
+		return false, err
+	}
+	// Stack-updates are sorted newest first
+	for _, stackUpdate := range stackUpdates {
+		if stackUpdate.Status != "success" {
+			continue
+		}
+		switch stackUpdate.Operation {
+		case operationDeploy, "":
+			return true, nil
+		case operationDestroy:
+			return false, nil
+		}
+	}
+	return false, nil
 }
 
 // GetStackUpdate retrieves a stack-update by ID.
@@ -129,23 +261,12 @@ func (s *StackUpdateService) GetStackUpdate(
 	if stackUpdate == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("stack update with ID '%s' not found", id))
 	}
-
-	protoStackUpdate := &stackupdatev1.StackUpdate{
-		Id:              stackUpdate.ID.Hex(),
-		CloudResourceId: stackUpdate.CloudResourceID,
-		Status:          stackUpdate.Status,
-		Output:          stackUpdate.Output,
-	}
-
-	if !stackUpdate.CreatedAt.IsZero() {
-		protoStackUpdate.CreatedAt = timestamppb.New(stackUpdate.CreatedAt)
-	}
-	if !stackUpdate.UpdatedAt.IsZero() {
-		protoStackUpdate.UpdatedAt = timestamppb.New(stackUpdate.UpdatedAt)
+	if err := auth.Authorize(ctx, auth.RoleViewer, stackUpdate.Org, stackUpdate.Env); err != nil {
+		return nil, err
 	}
 
 	return connect.NewResponse(&stackupdatev1.GetStackUpdateResponse{
-		StackUpdate: protoStackUpdate,
+		StackUpdate: toProtoStackUpdate(stackUpdate),
 	}), nil
 }
 
@@ -154,7 +275,11 @@ func (s *StackUpdateService) ListStackUpdates(
 	ctx context.Context,
 	req *connect.Request[stackupdatev1.ListStackUpdatesRequest],
 ) (*connect.Response[stackupdatev1.ListStackUpdatesResponse], error) {
-	opts := &database.StackUpdateListOptions{}
+	scopes, visible := visibleScopes(ctx, auth.RoleViewer)
+	if !visible {
+		return connect.NewResponse(&stackupdatev1.ListStackUpdatesResponse{}), nil
+	}
+	opts := &database.StackUpdateListOptions{Scopes: scopes}
 
 	if req.Msg.CloudResourceId != "" {
 		id := req.Msg.CloudResourceId
@@ -194,26 +319,12 @@ func (s *StackUpdateService) ListStackUpdates(
 
 	protoStackUpdates := make([]*stackupdatev1.StackUpdate, 0, len(stackUpdates))
 	for _, stackUpdate := range stackUpdates {
-		protoStackUpdate := &stackupdatev1.StackUpdate{
-			Id:              stackUpdate.ID.Hex(),
-			CloudResourceId: stackUpdate.CloudResourceID,
-			Status:          stackUpdate.Status,
-			Output:          stackUpdate.Output,
-		}
-
-		if !stackUpdate.CreatedAt.IsZero() {
-			protoStackUpdate.CreatedAt = timestamppb.New(stackUpdate.CreatedAt)
-		}
-		if !stackUpdate.UpdatedAt.IsZero() {
-			protoStackUpdate.UpdatedAt = timestamppb.New(stackUpdate.UpdatedAt)
-		}
-
-		protoStackUpdates = append(protoStackUpdates, protoStackUpdate)
+		protoStackUpdates = append(protoStackUpdates, toProtoStackUpdate(stackUpdate))
 	}
 
 	response := &stackupdatev1.ListStackUpdatesResponse{
-		StackUpdates:       protoStackUpdates,
-		TotalPages: totalPages,
+		StackUpdates: protoStackUpdates,
+		TotalPages:   totalPages,
 	}
 
 	return connect.NewResponse(response), nil
@@ -240,6 +351,9 @@ func (s *StackUpdateService) StreamStackUpdateOutput(
 	if stackUpdate == nil {
 		return connect.NewError(connect.CodeNotFound, fmt.Errorf("stack-update with ID '%s' not found", stackUpdateID))
 	}
+	if err := auth.Authorize(ctx, auth.RoleViewer, stackUpdate.Org, stackUpdate.Env); err != nil {
+		return err
+	}
 
 	// Get last sequence number if provided (for resuming)
 	// If not provided, start from -1 which means fetch all existing logs from sequence 0
@@ -376,18 +490,19 @@ func (s *StackUpdateService) StreamStackUpdateOutput(
 	}
 }
 
-// deployWithPulumi executes pulumi up and stores output in stackupdates table
+// runPulumi executes the pulumi command of the operation (up, destroy, preview or refresh)
+// and stores output in stackupdates table.
 // This function performs all required setup steps before executing Pulumi:
 // 1. Loads and validates manifest
 // 2. Extracts stack FQDN and kind
 // 3. Gets Pulumi module path
 // 4. Initializes stack if needed
 // 5. Updates Pulumi.yaml project name
-// 6. Resolves credentials from database based on environment and provider
+// 6. Resolves the provider config of the selected credential
 // 7. Builds stack input YAML (with credentials)
-// 8. Executes pulumi up with resolved credentials
-func (s *StackUpdateService) deployWithPulumi(ctx context.Context, stackUpdateID string, cloudResourceID string, manifestYaml string) error {
-	fmt.Printf("DEBUG: deployWithPulumi started for stackUpdateID=%s, cloudResourceID=%s\n", stackUpdateID, cloudResourceID)
+// 8. Executes the pulumi command with resolved credentials
+func (s *StackUpdateService) runPulumi(ctx context.Context, stackUpdateID string, cloudResourceID string, credentialID string, manifestYaml string, operation string) error {
+	fmt.Printf("DEBUG: runPulumi started for stackUpdateID=%s, cloudResourceID=%s, operation=%s\n", stackUpdateID, cloudResourceID, operation)
 
 	// Step 1: Write manifest to temp file
 	tmpFile, err := os.CreateTemp("", "manifest-*.yaml")
@@ -482,10 +597,8 @@ func (s *StackUpdateService) deployWithPulumi(ctx context.Context, stackUpdateID
 		// Stack already exists, continue
 	}
 
-	// Step 10: Resolve provider credentials from database
-	// Credentials are resolved based on the provider from the cloud resource kind
-	// The provider is automatically determined from the kind (e.g., GcpCloudSql -> gcp)
-	credentialConfig, err := s.credentialResolver.ResolveProviderConfig(ctx, kindName)
+	// Step 10: Resolve the provider config of the credential selected when the stack-update was created
+	credentialConfig, err := s.credentialResolver.ResolveProviderConfig(ctx, credentialID)
 	if err != nil {
 		return s.updateStackUpdateWithError(ctx, stackUpdateID, fmt.Errorf("failed to resolve provider credentials: %w", err))
 	}
@@ -543,28 +656,31 @@ func (s *StackUpdateService) deployWithPulumi(ctx context.Context, stackUpdateID
 	}
 
 	// Step 12.5: Refresh Pulumi state to sync with reality
-	// This detects resources that were manually deleted and updates state accordingly
-	// This prevents errors when Pulumi tries to delete resources that no longer exist
-	fmt.Printf("Refreshing Pulumi state to sync with actual resources...\n")
-	refreshCtx, refreshCancel := context.WithTimeout(ctx, 300*time.Second) // 5 minutes for refresh
-	defer refreshCancel()
-
-	refreshCmd := exec.CommandContext(refreshCtx, "pulumi", "refresh", "--stack", stackFqdn, "--yes", "--skip-preview")
-	refreshCmd.Dir = pulumiModulePath
-	refreshCmd.Env = os.Environ()
-	if stackInputYaml != "" {
-		refreshCmd.Env = append(refreshCmd.Env, fmt.Sprintf("STACK_INPUT_YAML=%s", stackInputYaml))
-	}
-	refreshCmd.Env = append(refreshCmd.Env, fmt.Sprintf("PROJECT_PLANTON_MANIFEST=%s", manifestYaml))
-
-	// Run refresh - don't fail if it errors, just log it
-	// Refresh errors are non-critical - we'll proceed with pulumi up anyway
-	refreshOutput, refreshErr := refreshCmd.CombinedOutput()
-	if refreshErr != nil {
-		fmt.Printf("Warning: Pulumi refresh failed (non-critical, continuing with deployment): %v\n", refreshErr)
-		fmt.Printf("Refresh output: %s\n", string(refreshOutput))
-	} else {
-		fmt.Printf("Pulumi state refreshed successfully\n")
+	// Preview and refresh leave the state alone, so they skip it
+	if operation == operationDeploy || operation == operationDestroy {
+		// This detects resources that were manually deleted and updates state accordingly
+		// This prevents errors when Pulumi tries to delete resources that no longer exist
+		fmt.Printf("Refreshing Pulumi state to sync with actual resources...\n")
+		refreshCtx, refreshCancel := context.WithTimeout(ctx, 300*time.Second) // 5 minutes for refresh
+		defer refreshCancel()
+
+		refreshCmd := exec.CommandContext(refreshCtx, "pulumi", "refresh", "--stack", stackFqdn, "--yes", "--skip-preview")
+		refreshCmd.Dir = pulumiModulePath
+		refreshCmd.Env = os.Environ()
+		if stackInputYaml != "" {
+			refreshCmd.Env = append(refreshCmd.Env, fmt.Sprintf("STACK_INPUT_YAML=%s", stackInputYaml))
+		}
+		refreshCmd.Env = append(refreshCmd.Env, fmt.Sprintf("PROJECT_PLANTON_MANIFEST=%s", manifestYaml))
+
+		// Run refresh - don't fail if it errors, just log it
+		// Refresh errors are non-critical - we'll proceed with pulumi up anyway
+		refreshOutput, refreshErr := refreshCmd.CombinedOutput()
+		if refreshErr != nil {
+			fmt.Printf("Warning: Pulumi refresh failed (non-critical, continuing with deployment): %v\n", refreshErr)
+			fmt.Printf("Refresh output: %s\n", string(refreshOutput))
+		} else {
+			fmt.Printf("Pulumi state refreshed successfully\n")
+		}
 	}
 
 	// Step 13: Execute Pulumi command with streaming output
@@ -574,10 +690,11 @@ func (s *StackUpdateService) deployWithPulumi(ctx context.Context, stackUpdateID
 	defer cancel()
 
 	pulumiArgs := []string{
-		"up",
+		pulumiCommand(operation),
 		"--stack", stackFqdn,
-		"--yes",
-		"--skip-preview",
+	}
+	if operation != operationPreview {
+		pulumiArgs = append(pulumiArgs, "--yes", "--skip-preview")
 	}
 
 	cmd := exec.CommandContext(cmdCtx, "pulumi", pulumiArgs...)
@@ -798,6 +915,20 @@ func (s *StackUpdateService) deployWithPulumi(ctx context.Context, stackUpdateID
 	return nil
 }
 
+// pulumiCommand returns the pulumi command that runs the stack-update operation.
+func pulumiCommand(operation string) string {
+	switch operation {
+	case operationDestroy:
+		return "destroy"
+	case operationPreview:
+		return "preview"
+	case operationRefresh:
+		return "refresh"
+	default:
+		return "up"
+	}
+}
+
 // ensureStackInitialized ensures the Pulumi stack exists, initializing it if needed
 func (s *StackUpdateService) ensureStackInitialized(ctx context.Context, stackUpdateID, moduleDir, stackFqdn, manifestPath, pulumiModulePath string) error {
 	// Check if stack exists by trying to select it
@@ -863,6 +994,75 @@ func (s *StackUpdateService) cancelStackLock(ctx context.Context, pulumiModulePa
 	return nil
 }
 
+// toProtoStackUpdate converts a stored stack-update to its API representation.
+func toProtoStackUpdate(stackUpdate *models.StackUpdate) *stackupdatev1.StackUpdate {
+	protoStackUpdate := &stackupdatev1.StackUpdate{
+		Id:              stackUpdate.ID.Hex(),
+		CloudResourceId: stackUpdate.CloudResourceID,
+		Status:          stackUpdate.Status,
+		Output:          stackUpdate.Output,
+		Provisioner:     stackUpdate.Provisioner,
+		Operation:       stackUpdate.Operation,
+		CredentialId:    stackUpdate.CredentialID,
+	}
+
+	if !stackUpdate.CreatedAt.IsZero() {
+		protoStackUpdate.CreatedAt = timestamppb.New(stackUpdate.CreatedAt)
+	}
+	if !stackUpdate.UpdatedAt.IsZero() {
+		protoStackUpdate.UpdatedAt = timestamppb.New(stackUpdate.UpdatedAt)
+	}
+	return protoStackUpdate
+}
+
+// loadManifestObject loads the manifest YAML of a cloud resource as its kind's proto message.
+func loadManifestObject(manifestYaml string) (proto.Message, error) {
+	manifestPath, err := writeManifestTempFile(manifestYaml)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(manifestPath)
+
+	manifestObject, err := manifest.LoadManifest(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manifest: %w", err)
+	}
+	return manifestObject, nil
+}
+
+// manifestProvisioner returns the provisioner named by the openmcf.org/provisioner label of the manifest.
+// Manifests without the label are deployed with Pulumi, as they were before tofu and terraform were supported.
+func manifestProvisioner(manifestObject proto.Message) (provisioner.ProvisionerType, error) {
+	provisionerType, err := provisioner.ExtractFromManifest(manifestObject)
+	if err != nil {
+		return provisioner.ProvisionerTypeUnspecified, err
+	}
+	if provisionerType == provisioner.ProvisionerTypeUnspecified {
+		return provisioner.ProvisionerTypePulumi, nil
+	}
+	return provisionerType, nil
+}
+
+// writeManifestTempFile writes the manifest YAML to a temp file and returns its path.
+// The caller must remove the file.
+func writeManifestTempFile(manifestYaml string) (string, error) {
+	tmpFile, err := os.CreateTemp("", "manifest-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	if _, err := tmpFile.WriteString(manifestYaml); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return "", fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return "", fmt.Errorf("failed to close temp file: %w", err)
+	}
+	return tmpFile.Name(), nil
+}
+
 // extractCredentialProto extracts the provider-specific proto message from a CredentialProviderConfig.
 // Returns nil if no credentials are set.
 func extractCredentialProto(config *credentialv1.CredentialProviderConfig) proto.Message {
@@ -11,6 +11,8 @@ type CloudResource struct {
 	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	Name      string             `bson:"name" json:"name"`
 	Kind      string             `bson:"kind" json:"kind"`
+	Org       string             `bson:"org,omitempty" json:"org,omitempty"` // metadata.org of the manifest
+	Env       string             `bson:"env,omitempty" json:"env,omitempty"` // metadata.env of the manifest
 	Manifest  string             `bson:"manifest" json:"manifest"`
 	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
@@ -115,3 +115,14 @@ type KubernetesCredential struct {
 	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
 }
+
+// ProviderConfigCredential represents credentials of providers stored as their provider config proto,
+// e.g. Civo, Cloudflare or OpenFGA. ProviderConfig holds the config as protojson.
+type ProviderConfigCredential struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name           string             `bson:"name" json:"name"`
+	Provider       string             `bson:"provider" json:"provider"`
+	ProviderConfig string             `bson:"provider_config" json:"provider_config"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updated_at"`
+}
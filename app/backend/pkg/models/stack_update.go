@@ -6,12 +6,17 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-// StackUpdate represents a Pulumi stack deployment job in MongoDB.
+// StackUpdate represents a stack deployment job in MongoDB.
 type StackUpdate struct {
 	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	CloudResourceID string             `bson:"cloud_resource_id" json:"cloud_resource_id"`
-	Status          string             `bson:"status" json:"status"`                     // success, failed, in_progress
-	Output          string             `bson:"output,omitempty" json:"output,omitempty"` // JSON string containing Pulumi output
+	Org             string             `bson:"org,omitempty" json:"org,omitempty"`                 // org of the cloud resource
+	Env             string             `bson:"env,omitempty" json:"env,omitempty"`                 // env of the cloud resource
+	Status          string             `bson:"status" json:"status"`                               // success, failed, in_progress
+	Output          string             `bson:"output,omitempty" json:"output,omitempty"`           // JSON string containing the deployment output
+	Provisioner     string             `bson:"provisioner,omitempty" json:"provisioner,omitempty"` // pulumi, tofu or terraform
+	Operation       string             `bson:"operation,omitempty" json:"operation,omitempty"`     // deploy, destroy, preview or refresh
+	CredentialID    string             `bson:"credential_id,omitempty" json:"credential_id,omitempty"`
 	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
 }
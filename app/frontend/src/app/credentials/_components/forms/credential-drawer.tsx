@@ -200,7 +200,13 @@ export function CredentialDrawer({
         });
       } else if (mode === 'edit' && selectedCredential) {
         command
-          .update(selectedCredential.id, formData.name, formData.provider, providerConfig)
+          .update(
+            selectedCredential.id,
+            formData.name,
+            formData.provider,
+            providerConfig,
+            selectedCredential.defaultForEnvs
+          )
           .then(() => {
             onSaveSuccess();
           });
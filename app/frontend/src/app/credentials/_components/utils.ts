@@ -38,5 +38,40 @@ export const providerConfig: Record<Credential_CredentialProvider, ProviderConfi
     description: 'Link your OpenFGA server to manage authorization resources',
     icon: undefined,
   },
+  [Credential_CredentialProvider.ATLAS]: {
+    label: 'MongoDB Atlas',
+    description: 'Link your MongoDB Atlas organization to manage database clusters',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.CIVO]: {
+    label: 'Civo',
+    description: 'Link your Civo account to start deploying infrastructure',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.CLOUDFLARE]: {
+    label: 'Cloudflare',
+    description: 'Link your Cloudflare account to manage edge resources',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.CONFLUENT]: {
+    label: 'Confluent Cloud',
+    description: 'Link your Confluent Cloud organization to manage Kafka resources',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.DIGITAL_OCEAN]: {
+    label: 'DigitalOcean',
+    description: 'Link your DigitalOcean team to start deploying infrastructure',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.KUBERNETES]: {
+    label: 'Kubernetes',
+    description: 'Link your Kubernetes cluster to deploy workloads',
+    icon: undefined,
+  },
+  [Credential_CredentialProvider.SNOWFLAKE]: {
+    label: 'Snowflake',
+    description: 'Link your Snowflake account to manage data warehouse resources',
+    icon: undefined,
+  },
 };
 
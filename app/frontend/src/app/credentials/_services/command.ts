@@ -76,7 +76,8 @@ export const useCredentialCommand = () => {
         id: string,
         name: string,
         provider: Credential_CredentialProvider,
-        providerConfig: UpdateCredentialRequest['providerConfig']
+        providerConfig: UpdateCredentialRequest['providerConfig'],
+        defaultForEnvs: string[] = []
       ): Promise<Credential> => {
         return new Promise((resolve, reject) => {
           setPageLoading(true);
@@ -86,6 +87,7 @@ export const useCredentialCommand = () => {
                 name,
                 provider,
                 providerConfig,
+                defaultForEnvs,
               })
             )
             .then((response: UpdateCredentialResponse) => {
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file org/openmcf/app/cloudresource/v1/io.proto.
  */
 export const file_org_openmcf_app_cloudresource_v1_io: GenFile = /*@__PURE__*/
-  fileDesc("Cilvcmcvb3Blbm1jZi9hcHAvY2xvdWRyZXNvdXJjZS92MS9pby5wcm90bxIgb3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEiLgoaQ3JlYXRlQ2xvdWRSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAkiYAobQ3JlYXRlQ2xvdWRSZXNvdXJjZVJlc3BvbnNlEkEKCHJlc291cmNlGAEgASgLMi8ub3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEuQ2xvdWRSZXNvdXJjZSJfChlMaXN0Q2xvdWRSZXNvdXJjZXNSZXF1ZXN0EgwKBGtpbmQYASABKAkSNAoJcGFnZV9pbmZvGAIgASgLMiEub3JnLm9wZW5tY2YuYXBwLmNvbW1vbnMuUGFnZUluZm8idQoaTGlzdENsb3VkUmVzb3VyY2VzUmVzcG9uc2USQgoJcmVzb3VyY2VzGAEgAygLMi8ub3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEuQ2xvdWRSZXNvdXJjZRITCgt0b3RhbF9wYWdlcxgCIAEoBSIlChdHZXRDbG91ZFJlc291cmNlUmVxdWVzdBIKCgJpZBgBIAEoCSJdChhHZXRDbG91ZFJlc291cmNlUmVzcG9uc2USQQoIcmVzb3VyY2UYASABKAsyLy5vcmcub3Blbm1jZi5hcHAuY2xvdWRyZXNvdXJjZS52MS5DbG91ZFJlc291cmNlIjoKGlVwZGF0ZUNsb3VkUmVzb3VyY2VSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCG1hbmlmZXN0GAIgASgJImAKG1VwZGF0ZUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCghyZXNvdXJjZRgBIAEoCzIvLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxLkNsb3VkUmVzb3VyY2UiKAoaRGVsZXRlQ2xvdWRSZXNvdXJjZVJlcXVlc3QSCgoCaWQYASABKAkiLgobRGVsZXRlQ2xvdWRSZXNvdXJjZVJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiLQoZQXBwbHlDbG91ZFJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoCSJwChpBcHBseUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCghyZXNvdXJjZRgBIAEoCzIvLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxLkNsb3VkUmVzb3VyY2USDwoHY3JlYXRlZBgCIAEoCCIqChpDb3VudENsb3VkUmVzb3VyY2VzUmVxdWVzdBIMCgRraW5kGAEgASgJIiwKG0NvdW50Q2xvdWRSZXNvdXJjZXNSZXNwb25zZRINCgVjb3VudBgBIAEoA0KoAgokY29tLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxQgdJb1Byb3RvUAFaUmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvY2xvdWRyZXNvdXJjZS92MTtjbG91ZHJlc291cmNldjGiAgRPT0FDqgIgT3JnLk9wZW5tY2YuQXBwLkNsb3VkcmVzb3VyY2UuVjHKAiBPcmdcT3Blbm1jZlxBcHBcQ2xvdWRyZXNvdXJjZVxWMeICLE9yZ1xPcGVubWNmXEFwcFxDbG91ZHJlc291cmNlXFYxXEdQQk1ldGFkYXRh6gIkT3JnOjpPcGVubWNmOjpBcHA6OkNsb3VkcmVzb3VyY2U6OlYxYgZwcm90bzM", [file_org_openmcf_app_cloudresource_v1_api, file_org_openmcf_app_commons_page_info]);
+  fileDesc("Cilvcmcvb3Blbm1jZi9hcHAvY2xvdWRyZXNvdXJjZS92MS9pby5wcm90bxIgb3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEiLgoaQ3JlYXRlQ2xvdWRSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAkiYAobQ3JlYXRlQ2xvdWRSZXNvdXJjZVJlc3BvbnNlEkEKCHJlc291cmNlGAEgASgLMi8ub3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEuQ2xvdWRSZXNvdXJjZSJfChlMaXN0Q2xvdWRSZXNvdXJjZXNSZXF1ZXN0EgwKBGtpbmQYASABKAkSNAoJcGFnZV9pbmZvGAIgASgLMiEub3JnLm9wZW5tY2YuYXBwLmNvbW1vbnMuUGFnZUluZm8idQoaTGlzdENsb3VkUmVzb3VyY2VzUmVzcG9uc2USQgoJcmVzb3VyY2VzGAEgAygLMi8ub3JnLm9wZW5tY2YuYXBwLmNsb3VkcmVzb3VyY2UudjEuQ2xvdWRSZXNvdXJjZRITCgt0b3RhbF9wYWdlcxgCIAEoBSIlChdHZXRDbG91ZFJlc291cmNlUmVxdWVzdBIKCgJpZBgBIAEoCSJdChhHZXRDbG91ZFJlc291cmNlUmVzcG9uc2USQQoIcmVzb3VyY2UYASABKAsyLy5vcmcub3Blbm1jZi5hcHAuY2xvdWRyZXNvdXJjZS52MS5DbG91ZFJlc291cmNlIjoKGlVwZGF0ZUNsb3VkUmVzb3VyY2VSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCG1hbmlmZXN0GAIgASgJImAKG1VwZGF0ZUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCghyZXNvdXJjZRgBIAEoCzIvLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxLkNsb3VkUmVzb3VyY2UiOQoaRGVsZXRlQ2xvdWRSZXNvdXJjZVJlcXVlc3QSCgoCaWQYASABKAkSDwoHZGVzdHJveRgCIAEoCCJHChtEZWxldGVDbG91ZFJlc291cmNlUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCRIXCg9zdGFja191cGRhdGVfaWQYAiABKAkiLQoZQXBwbHlDbG91ZFJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoCSJwChpBcHBseUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCghyZXNvdXJjZRgBIAEoCzIvLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxLkNsb3VkUmVzb3VyY2USDwoHY3JlYXRlZBgCIAEoCCIqChpDb3VudENsb3VkUmVzb3VyY2VzUmVxdWVzdBIMCgRraW5kGAEgASgJIiwKG0NvdW50Q2xvdWRSZXNvdXJjZXNSZXNwb25zZRINCgVjb3VudBgBIAEoA0KoAgokY29tLm9yZy5vcGVubWNmLmFwcC5jbG91ZHJlc291cmNlLnYxQgdJb1Byb3RvUAFaUmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvY2xvdWRyZXNvdXJjZS92MTtjbG91ZHJlc291cmNldjGiAgRPT0FDqgIgT3JnLk9wZW5tY2YuQXBwLkNsb3VkcmVzb3VyY2UuVjHKAiBPcmdcT3Blbm1jZlxBcHBcQ2xvdWRyZXNvdXJjZVxWMeICLE9yZ1xPcGVubWNmXEFwcFxDbG91ZHJlc291cmNlXFYxXEdQQk1ldGFkYXRh6gIkT3JnOjpPcGVubWNmOjpBcHA6OkNsb3VkcmVzb3VyY2U6OlYxYgZwcm90bzM", [file_org_openmcf_app_cloudresource_v1_api, file_org_openmcf_app_commons_page_info]);
 
 /**
  * Request message for creating a cloud resource.
@@ -220,6 +220,15 @@ export type DeleteCloudResourceRequest = Message<"org.openmcf.app.cloudresource.
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * Destroy the infrastructure of the cloud resource before deleting the record.
+   * The record is deleted once the destroy stack-update succeeds.
+   * Without it, the record is only deleted when no deployed resources remain.
+   *
+   * @generated from field: bool destroy = 2;
+   */
+  destroy: boolean;
 };
 
 /**
@@ -241,6 +250,13 @@ export type DeleteCloudResourceResponse = Message<"org.openmcf.app.cloudresource
    * @generated from field: string message = 1;
    */
   message: string;
+
+  /**
+   * The destroy stack-update, set when destroy was requested.
+   *
+   * @generated from field: string stack_update_id = 2;
+   */
+  stackUpdateId: string;
 };
 
 /**
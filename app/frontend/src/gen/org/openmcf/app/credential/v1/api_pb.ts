@@ -36,7 +36,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file org/openmcf/app/credential/v1/api.proto.
  */
 export const file_org_openmcf_app_credential_v1_api: GenFile = /*@__PURE__*/
-  fileDesc("Cidvcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MS9hcGkucHJvdG8SGW9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEikQMKCkNyZWRlbnRpYWwSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRJKCghwcm92aWRlchgDIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXISTAoPcHJvdmlkZXJfY29uZmlnGAQgASgLMjMub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsUHJvdmlkZXJDb25maWcSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAibwoSQ3JlZGVudGlhbFByb3ZpZGVyEiMKH0NSRURFTlRJQUxfUFJPVklERVJfVU5TUEVDSUZJRUQQABIHCgNHQ1AQARIHCgNBV1MQAhIJCgVBWlVSRRADEgkKBUFVVEgwEAQSDAoIT1BFTl9GR0EQBSL8BgoYQ3JlZGVudGlhbFByb3ZpZGVyQ29uZmlnEkAKBWF0bGFzGAEgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXRsYXMuQXRsYXNQcm92aWRlckNvbmZpZ0gAEjoKA2F3cxgCIAEoCzIrLm9yZy5vcGVubWNmLnByb3ZpZGVyLmF3cy5Bd3NQcm92aWRlckNvbmZpZ0gAEkAKBWF6dXJlGAMgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXp1cmUuQXp1cmVQcm92aWRlckNvbmZpZ0gAEj0KBGNpdm8YBCABKAsyLS5vcmcub3Blbm1jZi5wcm92aWRlci5jaXZvLkNpdm9Qcm92aWRlckNvbmZpZ0gAEk8KCmNsb3VkZmxhcmUYBSABKAsyOS5vcmcub3Blbm1jZi5wcm92aWRlci5jbG91ZGZsYXJlLkNsb3VkZmxhcmVQcm92aWRlckNvbmZpZ0gAEkwKCWNvbmZsdWVudBgGIAEoCzI3Lm9yZy5vcGVubWNmLnByb3ZpZGVyLmNvbmZsdWVudC5Db25mbHVlbnRQcm92aWRlckNvbmZpZ0gAElUKDGRpZ2l0YWxvY2VhbhgHIAEoCzI9Lm9yZy5vcGVubWNmLnByb3ZpZGVyLmRpZ2l0YWxvY2Vhbi5EaWdpdGFsT2NlYW5Qcm92aWRlckNvbmZpZ0gAEjoKA2djcBgIIAEoCzIrLm9yZy5vcGVubWNmLnByb3ZpZGVyLmdjcC5HY3BQcm92aWRlckNvbmZpZ0gAEk8KCmt1YmVybmV0ZXMYCSABKAsyOS5vcmcub3Blbm1jZi5wcm92aWRlci5rdWJlcm5ldGVzLkt1YmVybmV0ZXNQcm92aWRlckNvbmZpZ0gAEkwKCXNub3dmbGFrZRgKIAEoCzI3Lm9yZy5vcGVubWNmLnByb3ZpZGVyLnNub3dmbGFrZS5Tbm93Zmxha2VQcm92aWRlckNvbmZpZ0gAEkAKBWF1dGgwGAsgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXV0aDAuQXV0aDBQcm92aWRlckNvbmZpZ0gAEkYKB29wZW5mZ2EYDCABKAsyMy5vcmcub3Blbm1jZi5wcm92aWRlci5vcGVuZmdhLk9wZW5GZ2FQcm92aWRlckNvbmZpZ0gAQgYKBGRhdGFC/gEKHWNvbS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxQghBcGlQcm90b1ABWkxnaXRodWIuY29tL3BsYW50b25ocS9vcGVubWNmL2FwaXMvb3JnL29wZW5tY2YvYXBwL2NyZWRlbnRpYWwvdjE7Y3JlZGVudGlhbHYxogIDT09DqgIZT3JnLk9wZW5tY2YuQ3JlZGVudGlhbC5WMcoCGU9yZ1xPcGVubWNmXENyZWRlbnRpYWxcVjHiAiVPcmdcT3Blbm1jZlxDcmVkZW50aWFsXFYxXEdQQk1ldGFkYXRh6gIcT3JnOjpPcGVubWNmOjpDcmVkZW50aWFsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_org_openmcf_provider_atlas_provider, file_org_openmcf_provider_auth0_provider, file_org_openmcf_provider_aws_provider, file_org_openmcf_provider_azure_provider, file_org_openmcf_provider_civo_provider, file_org_openmcf_provider_cloudflare_provider, file_org_openmcf_provider_confluent_provider, file_org_openmcf_provider_digitalocean_provider, file_org_openmcf_provider_gcp_provider, file_org_openmcf_provider_kubernetes_provider, file_org_openmcf_provider_openfga_provider, file_org_openmcf_provider_snowflake_provider]);
+  fileDesc("Cidvcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MS9hcGkucHJvdG8SGW9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEikgQKCkNyZWRlbnRpYWwSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRJKCghwcm92aWRlchgDIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXISTAoPcHJvdmlkZXJfY29uZmlnGAQgASgLMjMub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsUHJvdmlkZXJDb25maWcSGAoQZGVmYXVsdF9mb3JfZW52cxgFIAMoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLVAQoSQ3JlZGVudGlhbFByb3ZpZGVyEiMKH0NSRURFTlRJQUxfUFJPVklERVJfVU5TUEVDSUZJRUQQABIHCgNHQ1AQARIHCgNBV1MQAhIJCgVBWlVSRRADEgkKBUFVVEgwEAQSDAoIT1BFTl9GR0EQBRIJCgVBVExBUxAGEggKBENJVk8QBxIOCgpDTE9VREZMQVJFEAgSDQoJQ09ORkxVRU5UEAkSEQoNRElHSVRBTF9PQ0VBThAKEg4KCktVQkVSTkVURVMQCxINCglTTk9XRkxBS0UQDCL8BgoYQ3JlZGVudGlhbFByb3ZpZGVyQ29uZmlnEkAKBWF0bGFzGAEgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXRsYXMuQXRsYXNQcm92aWRlckNvbmZpZ0gAEjoKA2F3cxgCIAEoCzIrLm9yZy5vcGVubWNmLnByb3ZpZGVyLmF3cy5Bd3NQcm92aWRlckNvbmZpZ0gAEkAKBWF6dXJlGAMgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXp1cmUuQXp1cmVQcm92aWRlckNvbmZpZ0gAEj0KBGNpdm8YBCABKAsyLS5vcmcub3Blbm1jZi5wcm92aWRlci5jaXZvLkNpdm9Qcm92aWRlckNvbmZpZ0gAEk8KCmNsb3VkZmxhcmUYBSABKAsyOS5vcmcub3Blbm1jZi5wcm92aWRlci5jbG91ZGZsYXJlLkNsb3VkZmxhcmVQcm92aWRlckNvbmZpZ0gAEkwKCWNvbmZsdWVudBgGIAEoCzI3Lm9yZy5vcGVubWNmLnByb3ZpZGVyLmNvbmZsdWVudC5Db25mbHVlbnRQcm92aWRlckNvbmZpZ0gAElUKDGRpZ2l0YWxvY2VhbhgHIAEoCzI9Lm9yZy5vcGVubWNmLnByb3ZpZGVyLmRpZ2l0YWxvY2Vhbi5EaWdpdGFsT2NlYW5Qcm92aWRlckNvbmZpZ0gAEjoKA2djcBgIIAEoCzIrLm9yZy5vcGVubWNmLnByb3ZpZGVyLmdjcC5HY3BQcm92aWRlckNvbmZpZ0gAEk8KCmt1YmVybmV0ZXMYCSABKAsyOS5vcmcub3Blbm1jZi5wcm92aWRlci5rdWJlcm5ldGVzLkt1YmVybmV0ZXNQcm92aWRlckNvbmZpZ0gAEkwKCXNub3dmbGFrZRgKIAEoCzI3Lm9yZy5vcGVubWNmLnByb3ZpZGVyLnNub3dmbGFrZS5Tbm93Zmxha2VQcm92aWRlckNvbmZpZ0gAEkAKBWF1dGgwGAsgASgLMi8ub3JnLm9wZW5tY2YucHJvdmlkZXIuYXV0aDAuQXV0aDBQcm92aWRlckNvbmZpZ0gAEkYKB29wZW5mZ2EYDCABKAsyMy5vcmcub3Blbm1jZi5wcm92aWRlci5vcGVuZmdhLk9wZW5GZ2FQcm92aWRlckNvbmZpZ0gAQgYKBGRhdGFC/gEKHWNvbS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxQghBcGlQcm90b1ABWkxnaXRodWIuY29tL3BsYW50b25ocS9vcGVubWNmL2FwaXMvb3JnL29wZW5tY2YvYXBwL2NyZWRlbnRpYWwvdjE7Y3JlZGVudGlhbHYxogIDT09DqgIZT3JnLk9wZW5tY2YuQ3JlZGVudGlhbC5WMcoCGU9yZ1xPcGVubWNmXENyZWRlbnRpYWxcVjHiAiVPcmdcT3Blbm1jZlxDcmVkZW50aWFsXFYxXEdQQk1ldGFkYXRh6gIcT3JnOjpPcGVubWNmOjpDcmVkZW50aWFsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_org_openmcf_provider_atlas_provider, file_org_openmcf_provider_auth0_provider, file_org_openmcf_provider_aws_provider, file_org_openmcf_provider_azure_provider, file_org_openmcf_provider_civo_provider, file_org_openmcf_provider_cloudflare_provider, file_org_openmcf_provider_confluent_provider, file_org_openmcf_provider_digitalocean_provider, file_org_openmcf_provider_gcp_provider, file_org_openmcf_provider_kubernetes_provider, file_org_openmcf_provider_openfga_provider, file_org_openmcf_provider_snowflake_provider]);
 
 /**
  * Generic credential message.
@@ -70,6 +70,14 @@ export type Credential = Message<"org.openmcf.credential.v1.Credential"> & {
    */
   providerConfig?: CredentialProviderConfig;
 
+  /**
+   * Environments (metadata.env of a manifest) this credential is the default for.
+   * A deployment in one of these environments uses this credential unless it names another.
+   *
+   * @generated from field: repeated string default_for_envs = 5;
+   */
+  defaultForEnvs: string[];
+
   /**
    * Creation timestamp.
    *
@@ -127,6 +135,41 @@ export enum Credential_CredentialProvider {
    * @generated from enum value: OPEN_FGA = 5;
    */
   OPEN_FGA = 5,
+
+  /**
+   * @generated from enum value: ATLAS = 6;
+   */
+  ATLAS = 6,
+
+  /**
+   * @generated from enum value: CIVO = 7;
+   */
+  CIVO = 7,
+
+  /**
+   * @generated from enum value: CLOUDFLARE = 8;
+   */
+  CLOUDFLARE = 8,
+
+  /**
+   * @generated from enum value: CONFLUENT = 9;
+   */
+  CONFLUENT = 9,
+
+  /**
+   * @generated from enum value: DIGITAL_OCEAN = 10;
+   */
+  DIGITAL_OCEAN = 10,
+
+  /**
+   * @generated from enum value: KUBERNETES = 11;
+   */
+  KUBERNETES = 11,
+
+  /**
+   * @generated from enum value: SNOWFLAKE = 12;
+   */
+  SNOWFLAKE = 12,
 }
 
 /**
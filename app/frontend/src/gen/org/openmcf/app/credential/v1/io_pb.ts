@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file org/openmcf/app/credential/v1/io.proto.
  */
 export const file_org_openmcf_app_credential_v1_io: GenFile = /*@__PURE__*/
-  fileDesc("CiZvcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MS9pby5wcm90bxIZb3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MSLBAQoXQ3JlYXRlQ3JlZGVudGlhbFJlcXVlc3QSDAoEbmFtZRgBIAEoCRJKCghwcm92aWRlchgCIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXISTAoPcHJvdmlkZXJfY29uZmlnGAMgASgLMjMub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsUHJvdmlkZXJDb25maWciVQoYQ3JlYXRlQ3JlZGVudGlhbFJlc3BvbnNlEjkKCmNyZWRlbnRpYWwYASABKAsyJS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwiZAoWTGlzdENyZWRlbnRpYWxzUmVxdWVzdBJKCghwcm92aWRlchgBIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXIiXAoXTGlzdENyZWRlbnRpYWxzUmVzcG9uc2USQQoLY3JlZGVudGlhbHMYASADKAsyLC5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWxTdW1tYXJ5ItkBChFDcmVkZW50aWFsU3VtbWFyeRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEkoKCHByb3ZpZGVyGAMgASgOMjgub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsLkNyZWRlbnRpYWxQcm92aWRlchIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIiChRHZXRDcmVkZW50aWFsUmVxdWVzdBIKCgJpZBgBIAEoCSJSChVHZXRDcmVkZW50aWFsUmVzcG9uc2USOQoKY3JlZGVudGlhbBgBIAEoCzIlLm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbCLNAQoXVXBkYXRlQ3JlZGVudGlhbFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRJKCghwcm92aWRlchgDIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXISTAoPcHJvdmlkZXJfY29uZmlnGAQgASgLMjMub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsUHJvdmlkZXJDb25maWciVQoYVXBkYXRlQ3JlZGVudGlhbFJlc3BvbnNlEjkKCmNyZWRlbnRpYWwYASABKAsyJS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwiJQoXRGVsZXRlQ3JlZGVudGlhbFJlcXVlc3QSCgoCaWQYASABKAkiKwoYRGVsZXRlQ3JlZGVudGlhbFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAlC/QEKHWNvbS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxQgdJb1Byb3RvUAFaTGdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MTtjcmVkZW50aWFsdjGiAgNPT0OqAhlPcmcuT3Blbm1jZi5DcmVkZW50aWFsLlYxygIZT3JnXE9wZW5tY2ZcQ3JlZGVudGlhbFxWMeICJU9yZ1xPcGVubWNmXENyZWRlbnRpYWxcVjFcR1BCTWV0YWRhdGHqAhxPcmc6Ok9wZW5tY2Y6OkNyZWRlbnRpYWw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_org_openmcf_app_credential_v1_api]);
+  fileDesc("CiZvcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MS9pby5wcm90bxIZb3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MSLbAQoXQ3JlYXRlQ3JlZGVudGlhbFJlcXVlc3QSDAoEbmFtZRgBIAEoCRJKCghwcm92aWRlchgCIAEoDjI4Lm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbC5DcmVkZW50aWFsUHJvdmlkZXISTAoPcHJvdmlkZXJfY29uZmlnGAMgASgLMjMub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsUHJvdmlkZXJDb25maWcSGAoQZGVmYXVsdF9mb3JfZW52cxgEIAMoCSJVChhDcmVhdGVDcmVkZW50aWFsUmVzcG9uc2USOQoKY3JlZGVudGlhbBgBIAEoCzIlLm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbCJkChZMaXN0Q3JlZGVudGlhbHNSZXF1ZXN0EkoKCHByb3ZpZGVyGAEgASgOMjgub3JnLm9wZW5tY2YuY3JlZGVudGlhbC52MS5DcmVkZW50aWFsLkNyZWRlbnRpYWxQcm92aWRlciJcChdMaXN0Q3JlZGVudGlhbHNSZXNwb25zZRJBCgtjcmVkZW50aWFscxgBIAMoCzIsLm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbFN1bW1hcnki8wEKEUNyZWRlbnRpYWxTdW1tYXJ5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSSgoIcHJvdmlkZXIYAyABKA4yOC5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwuQ3JlZGVudGlhbFByb3ZpZGVyEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhgKEGRlZmF1bHRfZm9yX2VudnMYBiADKAkiOgoUR2V0Q3JlZGVudGlhbFJlcXVlc3QSCgoCaWQYASABKAkSFgoOcmV2ZWFsX3NlY3JldHMYAiABKAgiUgoVR2V0Q3JlZGVudGlhbFJlc3BvbnNlEjkKCmNyZWRlbnRpYWwYASABKAsyJS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwi5wEKF1VwZGF0ZUNyZWRlbnRpYWxSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSSgoIcHJvdmlkZXIYAyABKA4yOC5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwuQ3JlZGVudGlhbFByb3ZpZGVyEkwKD3Byb3ZpZGVyX2NvbmZpZxgEIAEoCzIzLm9yZy5vcGVubWNmLmNyZWRlbnRpYWwudjEuQ3JlZGVudGlhbFByb3ZpZGVyQ29uZmlnEhgKEGRlZmF1bHRfZm9yX2VudnMYBSADKAkiVQoYVXBkYXRlQ3JlZGVudGlhbFJlc3BvbnNlEjkKCmNyZWRlbnRpYWwYASABKAsyJS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxLkNyZWRlbnRpYWwiJQoXRGVsZXRlQ3JlZGVudGlhbFJlcXVlc3QSCgoCaWQYASABKAkiKwoYRGVsZXRlQ3JlZGVudGlhbFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAlC/QEKHWNvbS5vcmcub3Blbm1jZi5jcmVkZW50aWFsLnYxQgdJb1Byb3RvUAFaTGdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvY3JlZGVudGlhbC92MTtjcmVkZW50aWFsdjGiAgNPT0OqAhlPcmcuT3Blbm1jZi5DcmVkZW50aWFsLlYxygIZT3JnXE9wZW5tY2ZcQ3JlZGVudGlhbFxWMeICJU9yZ1xPcGVubWNmXENyZWRlbnRpYWxcVjFcR1BCTWV0YWRhdGHqAhxPcmc6Ok9wZW5tY2Y6OkNyZWRlbnRpYWw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_org_openmcf_app_credential_v1_api]);
 
 /**
  * Request message for creating a credential.
@@ -30,7 +30,7 @@ export type CreateCredentialRequest = Message<"org.openmcf.credential.v1.CreateC
   name: string;
 
   /**
-   * Provider type (gcp, aws, azure).
+   * Provider type.
    *
    * @generated from field: org.openmcf.credential.v1.Credential.CredentialProvider provider = 2;
    */
@@ -40,6 +40,13 @@ export type CreateCredentialRequest = Message<"org.openmcf.credential.v1.CreateC
    * @generated from field: org.openmcf.credential.v1.CredentialProviderConfig provider_config = 3;
    */
   providerConfig?: CredentialProviderConfig;
+
+  /**
+   * Environments this credential is the default for.
+   *
+   * @generated from field: repeated string default_for_envs = 4;
+   */
+  defaultForEnvs: string[];
 };
 
 /**
@@ -77,7 +84,7 @@ export const CreateCredentialResponseSchema: GenMessage<CreateCredentialResponse
  */
 export type ListCredentialsRequest = Message<"org.openmcf.credential.v1.ListCredentialsRequest"> & {
   /**
-   * Optional filter by provider (gcp, aws, azure).
+   * Optional filter by provider.
    *
    * @generated from field: org.openmcf.credential.v1.Credential.CredentialProvider provider = 1;
    */
@@ -152,6 +159,13 @@ export type CredentialSummary = Message<"org.openmcf.credential.v1.CredentialSum
    * @generated from field: google.protobuf.Timestamp updated_at = 5;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Environments this credential is the default for.
+   *
+   * @generated from field: repeated string default_for_envs = 6;
+   */
+  defaultForEnvs: string[];
 };
 
 /**
@@ -173,6 +187,13 @@ export type GetCredentialRequest = Message<"org.openmcf.credential.v1.GetCredent
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * Return secret fields in plaintext. Secret fields are replaced with a placeholder unless this is set.
+   *
+   * @generated from field: bool reveal_secrets = 2;
+   */
+  revealSecrets: boolean;
 };
 
 /**
@@ -236,6 +257,13 @@ export type UpdateCredentialRequest = Message<"org.openmcf.credential.v1.UpdateC
    * @generated from field: org.openmcf.credential.v1.CredentialProviderConfig provider_config = 4;
    */
   providerConfig?: CredentialProviderConfig;
+
+  /**
+   * Environments this credential is the default for. Replaces the existing list.
+   *
+   * @generated from field: repeated string default_for_envs = 5;
+   */
+  defaultForEnvs: string[];
 };
 
 /**
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file org/openmcf/app/stackupdate/v1/api.proto.
  */
 export const file_org_openmcf_app_stackupdate_v1_api: GenFile = /*@__PURE__*/
-  fileDesc("Cihvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvYXBpLnByb3RvEh5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEitAEKC1N0YWNrVXBkYXRlEgoKAmlkGAEgASgJEhkKEWNsb3VkX3Jlc291cmNlX2lkGAIgASgJEg4KBnN0YXR1cxgDIAEoCRIOCgZvdXRwdXQYBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCmwIKImNvbS5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjFCCEFwaVByb3RvUAFaTmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjE7c3RhY2t1cGRhdGV2MaICBE9PQVOqAh5PcmcuT3Blbm1jZi5BcHAuU3RhY2t1cGRhdGUuVjHKAh5PcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjHiAipPcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjFcR1BCTWV0YWRhdGHqAiJPcmc6Ok9wZW5tY2Y6OkFwcDo6U3RhY2t1cGRhdGU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Cihvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvYXBpLnByb3RvEh5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEi8wEKC1N0YWNrVXBkYXRlEgoKAmlkGAEgASgJEhkKEWNsb3VkX3Jlc291cmNlX2lkGAIgASgJEg4KBnN0YXR1cxgDIAEoCRIOCgZvdXRwdXQYBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLcHJvdmlzaW9uZXIYByABKAkSEQoJb3BlcmF0aW9uGAggASgJEhUKDWNyZWRlbnRpYWxfaWQYCSABKAlCmwIKImNvbS5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjFCCEFwaVByb3RvUAFaTmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjE7c3RhY2t1cGRhdGV2MaICBE9PQVOqAh5PcmcuT3Blbm1jZi5BcHAuU3RhY2t1cGRhdGUuVjHKAh5PcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjHiAipPcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjFcR1BCTWV0YWRhdGHqAiJPcmc6Ok9wZW5tY2Y6OkFwcDo6U3RhY2t1cGRhdGU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * StackUpdate represents a Pulumi/Tofu/Terraform stack update.
@@ -43,7 +43,7 @@ export type StackUpdate = Message<"org.openmcf.app.stackupdate.v1.StackUpdate">
   status: string;
 
   /**
-   * Pulumi deployment output as JSON string containing: status, stdout, stderr, exit_code, timestamp, stack_fqdn, error
+   * Deployment output as JSON string containing: status, stdout, stderr, exit_code, timestamp, stack_fqdn, error
    *
    * @generated from field: string output = 4;
    */
@@ -62,6 +62,28 @@ export type StackUpdate = Message<"org.openmcf.app.stackupdate.v1.StackUpdate">
    * @generated from field: google.protobuf.Timestamp updated_at = 6;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * The IaC provisioner that ran the stack-update (pulumi, tofu or terraform).
+   * Taken from the openmcf.org/provisioner manifest label, defaulting to pulumi.
+   *
+   * @generated from field: string provisioner = 7;
+   */
+  provisioner: string;
+
+  /**
+   * The operation the stack-update ran (deploy, destroy, preview or refresh).
+   *
+   * @generated from field: string operation = 8;
+   */
+  operation: string;
+
+  /**
+   * ID of the credential the stack-update ran with.
+   *
+   * @generated from field: string credential_id = 9;
+   */
+  credentialId: string;
 };
 
 /**
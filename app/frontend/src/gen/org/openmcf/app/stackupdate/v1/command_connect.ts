@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeployCloudResourceRequest, DeployCloudResourceResponse } from "./io_pb.js";
+import { DeployCloudResourceRequest, DeployCloudResourceResponse, DestroyCloudResourceRequest, DestroyCloudResourceResponse, PreviewCloudResourceRequest, PreviewCloudResourceResponse, RefreshCloudResourceRequest, RefreshCloudResourceResponse } from "./io_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -15,8 +15,8 @@ export const StackUpdateCommandController = {
   typeName: "org.openmcf.app.stackupdate.v1.StackUpdateCommandController",
   methods: {
     /**
-     * DeployCloudResource deploys a cloud resource using Pulumi.
-     * Takes a cloud resource ID, fetches the manifest, executes pulumi up, and stores the result in stackupdates table.
+     * DeployCloudResource deploys a cloud resource with the provisioner named in its manifest labels.
+     * Takes a cloud resource ID, fetches the manifest, executes pulumi up or tofu/terraform apply, and stores the result in stackupdates table.
      *
      * @generated from rpc org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DeployCloudResource
      */
@@ -26,6 +26,42 @@ export const StackUpdateCommandController = {
       O: DeployCloudResourceResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * DestroyCloudResource destroys the infrastructure of a cloud resource.
+     * Runs pulumi destroy or tofu/terraform destroy and keeps the cloud resource record.
+     *
+     * @generated from rpc org.openmcf.app.stackupdate.v1.StackUpdateCommandController.DestroyCloudResource
+     */
+    destroyCloudResource: {
+      name: "DestroyCloudResource",
+      I: DestroyCloudResourceRequest,
+      O: DestroyCloudResourceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PreviewCloudResource previews the changes a deploy would make without applying them.
+     * Runs pulumi preview or tofu/terraform plan.
+     *
+     * @generated from rpc org.openmcf.app.stackupdate.v1.StackUpdateCommandController.PreviewCloudResource
+     */
+    previewCloudResource: {
+      name: "PreviewCloudResource",
+      I: PreviewCloudResourceRequest,
+      O: PreviewCloudResourceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RefreshCloudResource syncs the stack state with the real infrastructure.
+     * Runs pulumi refresh or tofu/terraform refresh.
+     *
+     * @generated from rpc org.openmcf.app.stackupdate.v1.StackUpdateCommandController.RefreshCloudResource
+     */
+    refreshCloudResource: {
+      name: "RefreshCloudResource",
+      I: RefreshCloudResourceRequest,
+      O: RefreshCloudResourceResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
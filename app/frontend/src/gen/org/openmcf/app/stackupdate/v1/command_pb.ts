@@ -11,7 +11,7 @@ import { file_org_openmcf_app_stackupdate_v1_io } from "./io_pb";
  * Describes the file org/openmcf/app/stackupdate/v1/command.proto.
  */
 export const file_org_openmcf_app_stackupdate_v1_command: GenFile = /*@__PURE__*/
-  fileDesc("Cixvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvY29tbWFuZC5wcm90bxIeb3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxMq8BChxTdGFja1VwZGF0ZUNvbW1hbmRDb250cm9sbGVyEo4BChNEZXBsb3lDbG91ZFJlc291cmNlEjoub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLkRlcGxveUNsb3VkUmVzb3VyY2VSZXF1ZXN0Gjsub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLkRlcGxveUNsb3VkUmVzb3VyY2VSZXNwb25zZUKfAgoiY29tLm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MUIMQ29tbWFuZFByb3RvUAFaTmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjE7c3RhY2t1cGRhdGV2MaICBE9PQVOqAh5PcmcuT3Blbm1jZi5BcHAuU3RhY2t1cGRhdGUuVjHKAh5PcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjHiAipPcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjFcR1BCTWV0YWRhdGHqAiJPcmc6Ok9wZW5tY2Y6OkFwcDo6U3RhY2t1cGRhdGU6OlYxYgZwcm90bzM", [file_org_openmcf_app_stackupdate_v1_io]);
+  fileDesc("Cixvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvY29tbWFuZC5wcm90bxIeb3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxMusEChxTdGFja1VwZGF0ZUNvbW1hbmRDb250cm9sbGVyEo4BChNEZXBsb3lDbG91ZFJlc291cmNlEjoub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLkRlcGxveUNsb3VkUmVzb3VyY2VSZXF1ZXN0Gjsub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLkRlcGxveUNsb3VkUmVzb3VyY2VSZXNwb25zZRKRAQoURGVzdHJveUNsb3VkUmVzb3VyY2USOy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuRGVzdHJveUNsb3VkUmVzb3VyY2VSZXF1ZXN0Gjwub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLkRlc3Ryb3lDbG91ZFJlc291cmNlUmVzcG9uc2USkQEKFFByZXZpZXdDbG91ZFJlc291cmNlEjsub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLlByZXZpZXdDbG91ZFJlc291cmNlUmVxdWVzdBo8Lm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MS5QcmV2aWV3Q2xvdWRSZXNvdXJjZVJlc3BvbnNlEpEBChRSZWZyZXNoQ2xvdWRSZXNvdXJjZRI7Lm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MS5SZWZyZXNoQ2xvdWRSZXNvdXJjZVJlcXVlc3QaPC5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuUmVmcmVzaENsb3VkUmVzb3VyY2VSZXNwb25zZUKfAgoiY29tLm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MUIMQ29tbWFuZFByb3RvUAFaTmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjE7c3RhY2t1cGRhdGV2MaICBE9PQVOqAh5PcmcuT3Blbm1jZi5BcHAuU3RhY2t1cGRhdGUuVjHKAh5PcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjHiAipPcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjFcR1BCTWV0YWRhdGHqAiJPcmc6Ok9wZW5tY2Y6OkFwcDo6U3RhY2t1cGRhdGU6OlYxYgZwcm90bzM", [file_org_openmcf_app_stackupdate_v1_io]);
 
 /**
  * StackUpdateCommandController provides operations for managing pulumi/terraform stack updates.
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file org/openmcf/app/stackupdate/v1/io.proto.
  */
 export const file_org_openmcf_app_stackupdate_v1_io: GenFile = /*@__PURE__*/
-  fileDesc("Cidvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvaW8ucHJvdG8SHm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MSI3ChpEZXBsb3lDbG91ZFJlc291cmNlUmVxdWVzdBIZChFjbG91ZF9yZXNvdXJjZV9pZBgBIAEoCSJgChtEZXBsb3lDbG91ZFJlc291cmNlUmVzcG9uc2USQQoMc3RhY2tfdXBkYXRlGAEgASgLMisub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLlN0YWNrVXBkYXRlIiMKFUdldFN0YWNrVXBkYXRlUmVxdWVzdBIKCgJpZBgBIAEoCSJbChZHZXRTdGFja1VwZGF0ZVJlc3BvbnNlEkEKDHN0YWNrX3VwZGF0ZRgBIAEoCzIrLm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MS5TdGFja1VwZGF0ZSJ6ChdMaXN0U3RhY2tVcGRhdGVzUmVxdWVzdBIZChFjbG91ZF9yZXNvdXJjZV9pZBgBIAEoCRIOCgZzdGF0dXMYAiABKAkSNAoJcGFnZV9pbmZvGAMgASgLMiEub3JnLm9wZW5tY2YuYXBwLmNvbW1vbnMuUGFnZUluZm8icwoYTGlzdFN0YWNrVXBkYXRlc1Jlc3BvbnNlEkIKDXN0YWNrX3VwZGF0ZXMYASADKAsyKy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuU3RhY2tVcGRhdGUSEwoLdG90YWxfcGFnZXMYAiABKAUiSwoeU3RyZWFtU3RhY2tVcGRhdGVPdXRwdXRSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCRIZChFsYXN0X3NlcXVlbmNlX251bRgCIAEoBSKcAQofU3RyZWFtU3RhY2tVcGRhdGVPdXRwdXRSZXNwb25zZRIUCgxzZXF1ZW5jZV9udW0YASABKAUSDwoHY29udGVudBgCIAEoCRITCgtzdHJlYW1fdHlwZRgDIAEoCRItCgl0aW1lc3RhbXAYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnN0YXR1cxgFIAEoCUKaAgoiY29tLm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MUIHSW9Qcm90b1ABWk5naXRodWIuY29tL3BsYW50b25ocS9vcGVubWNmL2FwaXMvb3JnL29wZW5tY2YvYXBwL3N0YWNrdXBkYXRlL3YxO3N0YWNrdXBkYXRldjGiAgRPT0FTqgIeT3JnLk9wZW5tY2YuQXBwLlN0YWNrdXBkYXRlLlYxygIeT3JnXE9wZW5tY2ZcQXBwXFN0YWNrdXBkYXRlXFYx4gIqT3JnXE9wZW5tY2ZcQXBwXFN0YWNrdXBkYXRlXFYxXEdQQk1ldGFkYXRh6gIiT3JnOjpPcGVubWNmOjpBcHA6OlN0YWNrdXBkYXRlOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_org_openmcf_app_commons_page_info, file_org_openmcf_app_stackupdate_v1_api]);
+  fileDesc("Cidvcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjEvaW8ucHJvdG8SHm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MSJOChpEZXBsb3lDbG91ZFJlc291cmNlUmVxdWVzdBIZChFjbG91ZF9yZXNvdXJjZV9pZBgBIAEoCRIVCg1jcmVkZW50aWFsX2lkGAIgASgJImAKG0RlcGxveUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCgxzdGFja191cGRhdGUYASABKAsyKy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuU3RhY2tVcGRhdGUiTwobRGVzdHJveUNsb3VkUmVzb3VyY2VSZXF1ZXN0EhkKEWNsb3VkX3Jlc291cmNlX2lkGAEgASgJEhUKDWNyZWRlbnRpYWxfaWQYAiABKAkiYQocRGVzdHJveUNsb3VkUmVzb3VyY2VSZXNwb25zZRJBCgxzdGFja191cGRhdGUYASABKAsyKy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuU3RhY2tVcGRhdGUiTwobUHJldmlld0Nsb3VkUmVzb3VyY2VSZXF1ZXN0EhkKEWNsb3VkX3Jlc291cmNlX2lkGAEgASgJEhUKDWNyZWRlbnRpYWxfaWQYAiABKAkiYQocUHJldmlld0Nsb3VkUmVzb3VyY2VSZXNwb25zZRJBCgxzdGFja191cGRhdGUYASABKAsyKy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuU3RhY2tVcGRhdGUiTwobUmVmcmVzaENsb3VkUmVzb3VyY2VSZXF1ZXN0EhkKEWNsb3VkX3Jlc291cmNlX2lkGAEgASgJEhUKDWNyZWRlbnRpYWxfaWQYAiABKAkiYQocUmVmcmVzaENsb3VkUmVzb3VyY2VSZXNwb25zZRJBCgxzdGFja191cGRhdGUYASABKAsyKy5vcmcub3Blbm1jZi5hcHAuc3RhY2t1cGRhdGUudjEuU3RhY2tVcGRhdGUiIwoVR2V0U3RhY2tVcGRhdGVSZXF1ZXN0EgoKAmlkGAEgASgJIlsKFkdldFN0YWNrVXBkYXRlUmVzcG9uc2USQQoMc3RhY2tfdXBkYXRlGAEgASgLMisub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxLlN0YWNrVXBkYXRlInoKF0xpc3RTdGFja1VwZGF0ZXNSZXF1ZXN0EhkKEWNsb3VkX3Jlc291cmNlX2lkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRI0CglwYWdlX2luZm8YAyABKAsyIS5vcmcub3Blbm1jZi5hcHAuY29tbW9ucy5QYWdlSW5mbyJzChhMaXN0U3RhY2tVcGRhdGVzUmVzcG9uc2USQgoNc3RhY2tfdXBkYXRlcxgBIAMoCzIrLm9yZy5vcGVubWNmLmFwcC5zdGFja3VwZGF0ZS52MS5TdGFja1VwZGF0ZRITCgt0b3RhbF9wYWdlcxgCIAEoBSJLCh5TdHJlYW1TdGFja1VwZGF0ZU91dHB1dFJlcXVlc3QSDgoGam9iX2lkGAEgASgJEhkKEWxhc3Rfc2VxdWVuY2VfbnVtGAIgASgFIpwBCh9TdHJlYW1TdGFja1VwZGF0ZU91dHB1dFJlc3BvbnNlEhQKDHNlcXVlbmNlX251bRgBIAEoBRIPCgdjb250ZW50GAIgASgJEhMKC3N0cmVhbV90eXBlGAMgASgJEi0KCXRpbWVzdGFtcBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGc3RhdHVzGAUgASgJQpoCCiJjb20ub3JnLm9wZW5tY2YuYXBwLnN0YWNrdXBkYXRlLnYxQgdJb1Byb3RvUAFaTmdpdGh1Yi5jb20vcGxhbnRvbmhxL29wZW5tY2YvYXBpcy9vcmcvb3Blbm1jZi9hcHAvc3RhY2t1cGRhdGUvdjE7c3RhY2t1cGRhdGV2MaICBE9PQVOqAh5PcmcuT3Blbm1jZi5BcHAuU3RhY2t1cGRhdGUuVjHKAh5PcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjHiAipPcmdcT3Blbm1jZlxBcHBcU3RhY2t1cGRhdGVcVjFcR1BCTWV0YWRhdGHqAiJPcmc6Ok9wZW5tY2Y6OkFwcDo6U3RhY2t1cGRhdGU6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_org_openmcf_app_commons_page_info, file_org_openmcf_app_stackupdate_v1_api]);
 
 /**
  * Request message for deploying a cloud resource.
@@ -27,12 +27,18 @@ export type DeployCloudResourceRequest = Message<"org.openmcf.app.stackupdate.v1
   /**
    * The unique identifier of the cloud resource to deploy.
    *
-   * Provider credentials are automatically resolved from the database
-   * based on the environment and provider type. No need to provide credentials in the request.
-   *
    * @generated from field: string cloud_resource_id = 1;
    */
   cloudResourceId: string;
+
+  /**
+   * ID of the credential to deploy with. When empty, the credential is selected by the
+   * openmcf.org/credential manifest label, then by the default credential of metadata.env,
+   * then as the only credential of the provider.
+   *
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
 };
 
 /**
@@ -63,6 +69,154 @@ export type DeployCloudResourceResponse = Message<"org.openmcf.app.stackupdate.v
 export const DeployCloudResourceResponseSchema: GenMessage<DeployCloudResourceResponse> = /*@__PURE__*/
   messageDesc(file_org_openmcf_app_stackupdate_v1_io, 1);
 
+/**
+ * Request message for destroying the infrastructure of a cloud resource.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest
+ */
+export type DestroyCloudResourceRequest = Message<"org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest"> & {
+  /**
+   * The unique identifier of the cloud resource to destroy.
+   * The cloud resource record is kept; delete it with CloudResourceCommandController.Delete.
+   *
+   * @generated from field: string cloud_resource_id = 1;
+   */
+  cloudResourceId: string;
+
+  /**
+   * ID of the credential to use. Selected the same way as for a deploy when empty.
+   *
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.DestroyCloudResourceRequest.
+ * Use `create(DestroyCloudResourceRequestSchema)` to create a new message.
+ */
+export const DestroyCloudResourceRequestSchema: GenMessage<DestroyCloudResourceRequest> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 2);
+
+/**
+ * Response message containing the created stack-update.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse
+ */
+export type DestroyCloudResourceResponse = Message<"org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse"> & {
+  /**
+   * The created stack-update.
+   *
+   * @generated from field: org.openmcf.app.stackupdate.v1.StackUpdate stack_update = 1;
+   */
+  stackUpdate?: StackUpdate;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.DestroyCloudResourceResponse.
+ * Use `create(DestroyCloudResourceResponseSchema)` to create a new message.
+ */
+export const DestroyCloudResourceResponseSchema: GenMessage<DestroyCloudResourceResponse> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 3);
+
+/**
+ * Request message for previewing the changes a deploy of a cloud resource would make.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest
+ */
+export type PreviewCloudResourceRequest = Message<"org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest"> & {
+  /**
+   * The unique identifier of the cloud resource to preview.
+   *
+   * @generated from field: string cloud_resource_id = 1;
+   */
+  cloudResourceId: string;
+
+  /**
+   * ID of the credential to use. Selected the same way as for a deploy when empty.
+   *
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.PreviewCloudResourceRequest.
+ * Use `create(PreviewCloudResourceRequestSchema)` to create a new message.
+ */
+export const PreviewCloudResourceRequestSchema: GenMessage<PreviewCloudResourceRequest> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 4);
+
+/**
+ * Response message containing the created stack-update.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse
+ */
+export type PreviewCloudResourceResponse = Message<"org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse"> & {
+  /**
+   * The created stack-update.
+   *
+   * @generated from field: org.openmcf.app.stackupdate.v1.StackUpdate stack_update = 1;
+   */
+  stackUpdate?: StackUpdate;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.PreviewCloudResourceResponse.
+ * Use `create(PreviewCloudResourceResponseSchema)` to create a new message.
+ */
+export const PreviewCloudResourceResponseSchema: GenMessage<PreviewCloudResourceResponse> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 5);
+
+/**
+ * Request message for refreshing the state of a cloud resource from the real infrastructure.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest
+ */
+export type RefreshCloudResourceRequest = Message<"org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest"> & {
+  /**
+   * The unique identifier of the cloud resource to refresh.
+   *
+   * @generated from field: string cloud_resource_id = 1;
+   */
+  cloudResourceId: string;
+
+  /**
+   * ID of the credential to use. Selected the same way as for a deploy when empty.
+   *
+   * @generated from field: string credential_id = 2;
+   */
+  credentialId: string;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.RefreshCloudResourceRequest.
+ * Use `create(RefreshCloudResourceRequestSchema)` to create a new message.
+ */
+export const RefreshCloudResourceRequestSchema: GenMessage<RefreshCloudResourceRequest> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 6);
+
+/**
+ * Response message containing the created stack-update.
+ *
+ * @generated from message org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse
+ */
+export type RefreshCloudResourceResponse = Message<"org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse"> & {
+  /**
+   * The created stack-update.
+   *
+   * @generated from field: org.openmcf.app.stackupdate.v1.StackUpdate stack_update = 1;
+   */
+  stackUpdate?: StackUpdate;
+};
+
+/**
+ * Describes the message org.openmcf.app.stackupdate.v1.RefreshCloudResourceResponse.
+ * Use `create(RefreshCloudResourceResponseSchema)` to create a new message.
+ */
+export const RefreshCloudResourceResponseSchema: GenMessage<RefreshCloudResourceResponse> = /*@__PURE__*/
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 7);
+
 /**
  * Request message for retrieving a stack-update by ID.
  *
@@ -82,7 +236,7 @@ export type GetStackUpdateRequest = Message<"org.openmcf.app.stackupdate.v1.GetS
  * Use `create(GetStackUpdateRequestSchema)` to create a new message.
  */
 export const GetStackUpdateRequestSchema: GenMessage<GetStackUpdateRequest> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 2);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 8);
 
 /**
  * Response message containing the retrieved stack-update.
@@ -103,7 +257,7 @@ export type GetStackUpdateResponse = Message<"org.openmcf.app.stackupdate.v1.Get
  * Use `create(GetStackUpdateResponseSchema)` to create a new message.
  */
 export const GetStackUpdateResponseSchema: GenMessage<GetStackUpdateResponse> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 3);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 9);
 
 /**
  * Request message for listing stack-updates.
@@ -138,7 +292,7 @@ export type ListStackUpdatesRequest = Message<"org.openmcf.app.stackupdate.v1.Li
  * Use `create(ListStackUpdatesRequestSchema)` to create a new message.
  */
 export const ListStackUpdatesRequestSchema: GenMessage<ListStackUpdatesRequest> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 4);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 10);
 
 /**
  * Response message containing a list of stack-updates.
@@ -166,7 +320,7 @@ export type ListStackUpdatesResponse = Message<"org.openmcf.app.stackupdate.v1.L
  * Use `create(ListStackUpdatesResponseSchema)` to create a new message.
  */
 export const ListStackUpdatesResponseSchema: GenMessage<ListStackUpdatesResponse> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 5);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 11);
 
 /**
  * Request message for streaming stack-update output.
@@ -194,7 +348,7 @@ export type StreamStackUpdateOutputRequest = Message<"org.openmcf.app.stackupdat
  * Use `create(StreamStackUpdateOutputRequestSchema)` to create a new message.
  */
 export const StreamStackUpdateOutputRequestSchema: GenMessage<StreamStackUpdateOutputRequest> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 6);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 12);
 
 /**
  * Response message containing a streaming output chunk.
@@ -243,5 +397,5 @@ export type StreamStackUpdateOutputResponse = Message<"org.openmcf.app.stackupda
  * Use `create(StreamStackUpdateOutputResponseSchema)` to create a new message.
  */
 export const StreamStackUpdateOutputResponseSchema: GenMessage<StreamStackUpdateOutputResponse> = /*@__PURE__*/
-  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 7);
+  messageDesc(file_org_openmcf_app_stackupdate_v1_io, 13);
 
@@ -60,9 +60,14 @@ func init() {
 		root.CredentialUpdateCmd,
 		root.Destroy,
 		root.Downgrade,
+		root.Drift,
+		root.Explain,
 		root.Init,
 		root.LoadManifest,
 		root.ModulesVersion,
+		root.New,
+		root.Outputs,
+		root.PolicyCmd,
 		root.Plan,
 		root.Pull,
 		root.Pulumi,
@@ -20,7 +20,16 @@ var Apply = &cobra.Command{
 	Long: `Apply infrastructure changes by automatically routing to the appropriate provisioner
 (Pulumi, Tofu, or Terraform) based on the manifest label 'openmcf.org/provisioner'.
 
-If the provisioner label is not present, you will be prompted to select one interactively.`,
+If the provisioner label is not present, you will be prompted to select one interactively.
+
+When --manifest points at a directory or a multi-document YAML file, every manifest is
+applied in dependency order. Dependencies come from metadata.relationships (depends_on,
+runs_on) and value_from references; independent manifests run in parallel up to --concurrency.
+Without --auto-approve (or --yes) each run asks for approval, so manifests run one at a time.
+
+Before applying, each manifest is checked against the policies in --policy-dir (default
+~/.openmcf/policies). Violations stop the apply unless --skip-policy is set; see
+'openmcf policy test'.`,
 	Example: `
 	# Apply from clipboard (manifest content already copied)
 	openmcf apply --clipboard
@@ -38,6 +47,13 @@ If the provisioner label is not present, you will be prompted to select one inte
 
 	# Apply with field overrides
 	openmcf apply -f manifest.yaml --set spec.version=v1.2.3
+
+	# Apply every manifest in a directory (or multi-document file) in dependency order
+	openmcf apply -f ./infra/ --auto-approve
+	openmcf apply -f ./infra/all.yaml --concurrency 2
+
+	# Apply despite policy violations
+	openmcf apply -f manifest.yaml --skip-policy
 	`,
 	Run: applyHandler,
 }
@@ -49,9 +65,25 @@ func init() {
 	iacflags.AddPulumiFlags(Apply)
 	iacflags.AddTofuApplyFlags(Apply)
 	iacflags.AddTofuInitFlags(Apply)
+	iacflags.AddStackSetFlags(Apply)
+	iacflags.AddPolicyFlags(Apply)
 }
 
 func applyHandler(cmd *cobra.Command, args []string) {
+	// A directory or multi-document manifest is run as a dependency-ordered stack set
+	stackSetPath, isStackSet, err := climanifest.ResolveStackSetPath(cmd)
+	if err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+	if isStackSet {
+		if err := iacrunner.RunStackSet(cmd, stackSetPath, iacrunner.StackSetApply); err != nil {
+			cliprint.PrintError(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	ctx, err := iacrunner.ResolveContext(cmd)
 	if err != nil {
 		// Only print error if it wasn't already handled (clipboard/manifest load errors are pre-handled)
@@ -62,6 +94,11 @@ func applyHandler(cmd *cobra.Command, args []string) {
 	}
 	defer ctx.Cleanup()
 
+	if err := iacrunner.ResolveReferences(ctx, cmd); err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
 	switch ctx.ProvisionerType {
 	case provisioner.ProvisionerTypePulumi:
 		if err := iacrunner.RunPulumi(ctx, cmd, pulumi.PulumiOperationType_update, false); err != nil {
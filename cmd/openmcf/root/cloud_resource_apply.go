@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"time"
 
@@ -78,7 +77,7 @@ func cloudResourceApplyHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := cloudresourcev1connect.NewCloudResourceCommandControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
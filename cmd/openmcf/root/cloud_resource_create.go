@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"time"
 
@@ -50,7 +49,7 @@ func cloudResourceCreateHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := cloudresourcev1connect.NewCloudResourceCommandControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
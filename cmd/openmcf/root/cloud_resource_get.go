@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"time"
 
@@ -43,7 +42,7 @@ func cloudResourceGetHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := cloudresourcev1connect.NewCloudResourceQueryControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"text/tabwriter"
 	"time"
@@ -45,7 +44,7 @@ func cloudResourceListHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := cloudresourcev1connect.NewCloudResourceQueryControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -2,6 +2,7 @@ package root
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 type Config struct {
 	BackendURL        string `yaml:"backend-url,omitempty"`
+	APIToken          string `yaml:"api-token,omitempty"`
 	WebAppContainerID string `yaml:"webapp-container-id,omitempty"`
 	WebAppVersion     string `yaml:"webapp-version,omitempty"`
 }
@@ -25,7 +27,7 @@ var ConfigCmd = &cobra.Command{
 var configSetCmd = &cobra.Command{
 	Use:   "set <key> <value>",
 	Short: "set a configuration value",
-	Long:  "Set a configuration value. Available keys: backend-url",
+	Long:  "Set a configuration value. Available keys: backend-url, api-token",
 	Args:  cobra.ExactArgs(2),
 	Run:   configSetHandler,
 }
@@ -33,7 +35,7 @@ var configSetCmd = &cobra.Command{
 var configGetCmd = &cobra.Command{
 	Use:   "get <key>",
 	Short: "get a configuration value",
-	Long:  "Get a configuration value. Available keys: backend-url",
+	Long:  "Get a configuration value. Available keys: backend-url, api-token",
 	Args:  cobra.ExactArgs(1),
 	Run:   configGetHandler,
 }
@@ -120,8 +122,10 @@ func configSetHandler(cmd *cobra.Command, args []string) {
 			os.Exit(1)
 		}
 		config.BackendURL = value
+	case "api-token":
+		config.APIToken = value
 	default:
-		fmt.Printf("Error: unknown configuration key '%s'. Available keys: backend-url\n", key)
+		fmt.Printf("Error: unknown configuration key '%s'. Available keys: backend-url, api-token\n", key)
 		os.Exit(1)
 	}
 
@@ -130,6 +134,11 @@ func configSetHandler(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
+	// Don't echo the token to the terminal
+	if key == "api-token" {
+		fmt.Printf("Configuration %s set\n", key)
+		return
+	}
 	fmt.Printf("Configuration %s set to %s\n", key, value)
 }
 
@@ -149,8 +158,14 @@ func configGetHandler(cmd *cobra.Command, args []string) {
 			os.Exit(1)
 		}
 		fmt.Println(config.BackendURL)
+	case "api-token":
+		if config.APIToken == "" {
+			fmt.Printf("api-token is not set\n")
+			os.Exit(1)
+		}
+		fmt.Println(config.APIToken)
 	default:
-		fmt.Printf("Error: unknown configuration key '%s'. Available keys: backend-url\n", key)
+		fmt.Printf("Error: unknown configuration key '%s'. Available keys: backend-url, api-token\n", key)
 		os.Exit(1)
 	}
 }
@@ -167,6 +182,10 @@ func configListHandler(cmd *cobra.Command, args []string) {
 		fmt.Printf("backend-url=%s\n", config.BackendURL)
 		hasConfig = true
 	}
+	if config.APIToken != "" {
+		fmt.Printf("api-token=%s\n", maskSensitive(config.APIToken))
+		hasConfig = true
+	}
 	if config.WebAppContainerID != "" {
 		fmt.Printf("webapp-container-id=%s\n", config.WebAppContainerID)
 		hasConfig = true
@@ -195,6 +214,30 @@ func GetBackendURL() (string, error) {
 	return config.BackendURL, nil
 }
 
+// GetBackendHTTPClient returns the HTTP client for backend API calls. It sends the configured
+// api-token as a bearer token, and is http.DefaultClient when no token is configured.
+func GetBackendHTTPClient() *http.Client {
+	config, err := loadConfig()
+	if err != nil || config.APIToken == "" {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		Transport: &bearerTokenTransport{token: config.APIToken, next: http.DefaultTransport},
+	}
+}
+
+// bearerTokenTransport adds an Authorization header to every request.
+type bearerTokenTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.next.RoundTrip(req)
+}
+
 // LoadConfigPublic loads the configuration (exported for other packages)
 func LoadConfigPublic() (*Config, error) {
 	return loadConfig()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -78,7 +77,7 @@ func credentialCreateHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := credentialv1connect.NewCredentialCommandControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"time"
 
@@ -43,7 +42,7 @@ func credentialDeleteHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := credentialv1connect.NewCredentialCommandControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -3,7 +3,6 @@ package root
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"text/tabwriter"
 	"time"
@@ -38,7 +37,7 @@ func credentialListHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := credentialv1connect.NewCredentialQueryControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -72,7 +71,7 @@ func credentialUpdateHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := credentialv1connect.NewCredentialCommandControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
@@ -23,6 +23,10 @@ var Destroy = &cobra.Command{
 
 If the provisioner label is not present, you will be prompted to select one interactively.
 
+When --manifest points at a directory or a multi-document YAML file, every manifest is
+destroyed in reverse dependency order, so dependents are removed before what they depend on.
+Without --auto-approve (or --yes) each run asks for approval, so manifests run one at a time.
+
 This command has 'delete' as an alias for kubectl-like experience.`,
 	Example: `
 	# Destroy with manifest file
@@ -38,6 +42,9 @@ This command has 'delete' as an alias for kubectl-like experience.`,
 
 	# Destroy with field overrides
 	openmcf destroy -f manifest.yaml --set spec.version=v1.2.3
+
+	# Destroy every manifest in a directory in reverse dependency order
+	openmcf destroy -f ./infra/ --auto-approve
 	`,
 	Run: destroyHandler,
 }
@@ -49,9 +56,24 @@ func init() {
 	iacflags.AddPulumiFlags(Destroy)
 	iacflags.AddTofuApplyFlags(Destroy)
 	iacflags.AddTofuInitFlags(Destroy)
+	iacflags.AddStackSetFlags(Destroy)
 }
 
 func destroyHandler(cmd *cobra.Command, args []string) {
+	// A directory or multi-document manifest is run as a dependency-ordered stack set
+	stackSetPath, isStackSet, err := climanifest.ResolveStackSetPath(cmd)
+	if err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+	if isStackSet {
+		if err := iacrunner.RunStackSet(cmd, stackSetPath, iacrunner.StackSetDestroy); err != nil {
+			cliprint.PrintError(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	ctx, err := iacrunner.ResolveContext(cmd)
 	if err != nil {
 		// Only print error if it wasn't already handled (clipboard/manifest load errors are pre-handled)
@@ -62,6 +84,8 @@ func destroyHandler(cmd *cobra.Command, args []string) {
 	}
 	defer ctx.Cleanup()
 
+	iacrunner.ResolveReferencesForDestroy(ctx, cmd)
+
 	switch ctx.ProvisionerType {
 	case provisioner.ProvisionerTypePulumi:
 		if err := iacrunner.RunPulumi(ctx, cmd, pulumi.PulumiOperationType_destroy, false); err != nil {
@@ -0,0 +1,114 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/cli/iacflags"
+	"github.com/plantonhq/openmcf/internal/cli/iacrunner"
+	climanifest "github.com/plantonhq/openmcf/internal/cli/manifest"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/iac/drift"
+	"github.com/spf13/cobra"
+)
+
+// driftExitCode is the exit code when drift is detected, distinct from 1 for errors.
+const driftExitCode = 2
+
+var Drift = &cobra.Command{
+	Use:   "drift",
+	Short: "detect drift between live infrastructure and the manifest without changing state",
+	Long: `Detect drift by comparing the live infrastructure with its expected state, routing to the
+provisioner (Pulumi, Tofu, or Terraform) from the manifest label 'openmcf.org/provisioner'.
+
+Pulumi runs 'pulumi preview --refresh --json' and Tofu/Terraform run 'plan -refresh-only'.
+Neither changes the state or any cloud resource.
+
+The report lists every drifted resource with property-level before and after values and can be
+printed as a table, JSON, or JUnit XML for CI. The command exits with code 0 when nothing
+drifted, 2 when drift is detected, and 1 on errors.`,
+	Example: `
+	# Detect drift
+	openmcf drift -f manifest.yaml
+
+	# Write a JSON report
+	openmcf drift -f manifest.yaml --format json --output-file drift.json
+
+	# Write a JUnit report for CI
+	openmcf drift -f manifest.yaml --format junit --output-file drift-report.xml
+	`,
+	Run: driftHandler,
+}
+
+func init() {
+	iacflags.AddManifestSourceFlags(Drift)
+	iacflags.AddProviderConfigFlags(Drift)
+	iacflags.AddExecutionFlags(Drift)
+	iacflags.AddPulumiFlags(Drift)
+	iacflags.AddTofuInitFlags(Drift)
+	Drift.PersistentFlags().String(string(flag.Format), string(drift.FormatTable),
+		"report format: table, json or junit")
+	Drift.PersistentFlags().String(string(flag.OutputFile), "",
+		"file to write the report to instead of stdout")
+}
+
+func driftHandler(cmd *cobra.Command, args []string) {
+	format, err := cmd.Flags().GetString(string(flag.Format))
+	flag.HandleFlagErr(err, flag.Format)
+	outputFile, err := cmd.Flags().GetString(string(flag.OutputFile))
+	flag.HandleFlagErr(err, flag.OutputFile)
+
+	ctx, err := iacrunner.ResolveContext(cmd)
+	if err != nil {
+		// Only print error if it wasn't already handled (clipboard/manifest load errors are pre-handled)
+		if !climanifest.IsClipboardError(err) && !manifest.IsManifestLoadError(err) {
+			cliprint.PrintError(err.Error())
+		}
+		os.Exit(1)
+	}
+	defer ctx.Cleanup()
+
+	if err := iacrunner.ResolveReferences(ctx, cmd); err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
+	report, err := iacrunner.DetectDrift(ctx, cmd)
+	if err != nil {
+		cliprint.PrintError(fmt.Sprintf("failed to detect drift: %v", err))
+		os.Exit(1)
+	}
+
+	if err := writeDriftReport(report, drift.Format(format), outputFile); err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
+	if report.HasDrift() {
+		// Deferred cleanup does not run on os.Exit
+		ctx.Cleanup()
+		os.Exit(driftExitCode)
+	}
+}
+
+// writeDriftReport renders the report to outputFile, or to stdout when no file is given.
+func writeDriftReport(report *drift.Report, format drift.Format, outputFile string) error {
+	if outputFile == "" {
+		return drift.Write(os.Stdout, report, format)
+	}
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", outputFile, err)
+	}
+	if err := drift.Write(f, report, format); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFile, err)
+	}
+	cliprint.PrintSuccess(fmt.Sprintf("Wrote drift report to %s", outputFile))
+	return nil
+}
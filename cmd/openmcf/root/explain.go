@@ -0,0 +1,51 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/manifest/explain"
+	"github.com/spf13/cobra"
+)
+
+var Explain = &cobra.Command{
+	Use:   "explain <kind>[.field.path]",
+	Short: "show the documentation of a cloud resource kind or one of its fields",
+	Long: `Show the documentation of a cloud resource kind or of a field in its manifest.
+
+For the selected field this prints its type, description, defaults and recommended
+defaults, and its validation rules, followed by the fields it contains. Field names
+can be given in camelCase as written in manifests, or in snake_case as in the protos.
+
+The documentation is embedded in the CLI, so no network access is needed.`,
+	Example: `
+	# Describe the AwsVpc kind and its top-level fields
+	openmcf explain AwsVpc
+
+	# Describe a single spec field
+	openmcf explain AwsVpc.spec.vpcCidr
+
+	# List every field of the spec as a tree
+	openmcf explain AwsVpc.spec --recursive
+	`,
+	Args: cobra.ExactArgs(1),
+	Run:  explainHandler,
+}
+
+func init() {
+	Explain.Flags().Bool(string(flag.Recursive), false, "list the fields of nested messages as a tree")
+}
+
+func explainHandler(cmd *cobra.Command, args []string) {
+	isRecursive, err := cmd.Flags().GetBool(string(flag.Recursive))
+	flag.HandleFlagErr(err, flag.Recursive)
+
+	out, err := explain.Explain(args[0], isRecursive)
+	if err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+	fmt.Print(out)
+}
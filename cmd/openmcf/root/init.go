@@ -66,7 +66,7 @@ func initHandler(cmd *cobra.Command, args []string) {
 	moduleDir, err := cmd.Flags().GetString(string(flag.ModuleDir))
 	flag.HandleFlagErrAndValue(err, flag.ModuleDir, moduleDir)
 
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	flag.HandleFlagErr(err, flag.Set)
 
 	// Check which manifest source is being used for informative messages
@@ -104,6 +104,8 @@ func initHandler(cmd *cobra.Command, args []string) {
 	if isTempOverrides {
 		defer os.Remove(finalManifestPath)
 		targetManifestPath = finalManifestPath
+		// The overrides are now part of the manifest; applying them again would repeat [+] appends
+		valueOverrides = nil
 		cliprint.PrintSuccess("Overrides applied")
 	}
 
@@ -195,7 +197,7 @@ func initHandler(cmd *cobra.Command, args []string) {
 	}
 }
 
-func initWithPulumi(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides map[string]string) {
+func initWithPulumi(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides []manifest.Override) {
 	// Stack can be provided via flag or extracted from manifest
 	stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
 	flag.HandleFlagErr(err, flag.Stack)
@@ -211,7 +213,7 @@ func initWithPulumi(cmd *cobra.Command, moduleDir, targetManifestPath string, va
 	cliprint.PrintPulumiSuccess()
 }
 
-func initWithTofu(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides map[string]string,
+func initWithTofu(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides []manifest.Override,
 	kubeContext string, manifestObject proto.Message, providerConfig *stackinputproviderconfig.ProviderConfig) {
 
 	backendTypeString, err := cmd.Flags().GetString(string(flag.BackendType))
@@ -298,7 +300,7 @@ func initWithTofu(cmd *cobra.Command, moduleDir, targetManifestPath string, valu
 	cliprint.PrintTofuSuccess()
 }
 
-func initWithTerraform(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides map[string]string,
+func initWithTerraform(cmd *cobra.Command, moduleDir, targetManifestPath string, valueOverrides []manifest.Override,
 	kubeContext string, manifestObject proto.Message, providerConfig *stackinputproviderconfig.ProviderConfig) {
 
 	backendTypeString, err := cmd.Flags().GetString(string(flag.BackendType))
@@ -42,11 +42,11 @@ var LoadManifest = &cobra.Command{
 
 func init() {
 	iacflags.AddManifestSourceFlags(LoadManifest)
-	LoadManifest.PersistentFlags().StringToString(string(flag.Set), map[string]string{}, "override resource manifest values using key=value pairs")
+	LoadManifest.PersistentFlags().Var(flag.NewSetValue(), string(flag.Set), "override resource manifest values using key=value pairs")
 }
 
 func loadManifestHandler(cmd *cobra.Command, args []string) {
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	flag.HandleFlagErr(err, flag.Set)
 
 	var manifestPath string
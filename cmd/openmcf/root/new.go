@@ -0,0 +1,102 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"github.com/plantonhq/openmcf/internal/apidocs"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/cli/prompt"
+	"github.com/plantonhq/openmcf/internal/manifest/scaffold"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var New = &cobra.Command{
+	Use:   "new <kind>",
+	Short: "generate a manifest for a cloud resource kind with recommended defaults",
+	Long: `Generate a YAML manifest for any cloud resource kind.
+
+Fields with a recommended default are set to it, required fields get a placeholder
+annotated with their validation rules, and every other spec field is written as a
+comment to uncomment as needed. Field documentation is added as comments.
+
+With --interactive, you are asked for each required field that has no recommended default.`,
+	Example: `
+	# Print an AwsVpc manifest
+	openmcf new AwsVpc
+
+	# Write it to a file with metadata filled in
+	openmcf new AwsVpc --name main-vpc --env prod --output-file vpc.yaml
+
+	# Answer prompts for required fields
+	openmcf new GcpGcsBucket --interactive --output-file bucket.yaml
+	`,
+	Args: cobra.ExactArgs(1),
+	Run:  newHandler,
+}
+
+func init() {
+	New.Flags().String(string(flag.Name), "", "metadata.name of the resource")
+	New.Flags().String(string(flag.Org), "", "metadata.org of the resource")
+	New.Flags().String(string(flag.Env), "", "metadata.env of the resource")
+	New.Flags().Bool(string(flag.Interactive), false, "prompt for required fields that have no recommended default")
+	New.Flags().StringP(string(flag.OutputFile), "o", "", "file to write the manifest to instead of stdout")
+}
+
+func newHandler(cmd *cobra.Command, args []string) {
+	kind := crkreflect.KindFromString(args[0])
+	if kind == cloudresourcekind.CloudResourceKind_unspecified || crkreflect.ToMessageMap[kind] == nil {
+		cliprint.PrintError(fmt.Sprintf("unknown cloud resource kind: %s", args[0]))
+		os.Exit(1)
+	}
+
+	name, err := cmd.Flags().GetString(string(flag.Name))
+	flag.HandleFlagErr(err, flag.Name)
+	org, err := cmd.Flags().GetString(string(flag.Org))
+	flag.HandleFlagErr(err, flag.Org)
+	env, err := cmd.Flags().GetString(string(flag.Env))
+	flag.HandleFlagErr(err, flag.Env)
+	isInteractive, err := cmd.Flags().GetBool(string(flag.Interactive))
+	flag.HandleFlagErr(err, flag.Interactive)
+	outputFile, err := cmd.Flags().GetString(string(flag.OutputFile))
+	flag.HandleFlagErr(err, flag.OutputFile)
+
+	opts := scaffold.Options{Name: name, Org: org, Env: env}
+
+	// Descriptions are a convenience, so the manifest is still generated without them
+	if apiDocs, err := apidocs.GetApiDocsJson(); err == nil {
+		opts.Describe = func(messageFullName protoreflect.FullName, fieldName protoreflect.Name) string {
+			return apidocs.FieldDescription(apiDocs, string(messageFullName), string(fieldName))
+		}
+	} else {
+		fmt.Fprintf(os.Stderr, "warning: field descriptions unavailable: %v\n", err)
+	}
+
+	if isInteractive {
+		if !prompt.IsInteractive() {
+			cliprint.PrintError(fmt.Sprintf("--%s requires a terminal", flag.Interactive))
+			os.Exit(1)
+		}
+		opts.Prompt = prompt.PromptForManifestField
+	}
+
+	content, err := scaffold.Generate(kind, opts)
+	if err != nil {
+		cliprint.PrintError(fmt.Sprintf("failed to generate manifest: %v", err))
+		os.Exit(1)
+	}
+
+	if outputFile == "" {
+		fmt.Print(string(content))
+		return
+	}
+	if err := os.WriteFile(outputFile, content, 0644); err != nil {
+		cliprint.PrintError(fmt.Sprintf("failed to write manifest to %s: %v", outputFile, err))
+		os.Exit(1)
+	}
+	cliprint.PrintSuccess(fmt.Sprintf("Wrote %s manifest to %s", crkreflect.ExtractKindNameByKind(kind), outputFile))
+}
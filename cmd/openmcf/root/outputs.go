@@ -0,0 +1,93 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/cli/iacflags"
+	"github.com/plantonhq/openmcf/internal/cli/iacrunner"
+	climanifest "github.com/plantonhq/openmcf/internal/cli/manifest"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/internal/stackoutputs"
+	"github.com/spf13/cobra"
+)
+
+var Outputs = &cobra.Command{
+	Use:   "outputs",
+	Short: "print the stack outputs of a deployed resource",
+	Long: `Read the stack outputs of a deployed resource from its Pulumi stack or Tofu/Terraform state
+and print them as the kind's typed StackOutputs message (the manifest's status.outputs).
+
+The outputs are also recorded in the local cache (~/.openmcf/outputs), which value_from
+references to resources outside the current run are resolved from. apply and refresh
+update the cache automatically.`,
+	Example: `
+	# Print stack outputs
+	openmcf outputs -f manifest.yaml
+
+	# Write a copy of the manifest with status.outputs populated
+	openmcf outputs -f manifest.yaml --write-status manifest-with-status.yaml
+
+	# Read outputs of a Pulumi stack
+	openmcf outputs -f manifest.yaml --stack my-org/project/prod
+	`,
+	Run: outputsHandler,
+}
+
+func init() {
+	iacflags.AddManifestSourceFlags(Outputs)
+	iacflags.AddProviderConfigFlags(Outputs)
+	iacflags.AddExecutionFlags(Outputs)
+	iacflags.AddPulumiFlags(Outputs)
+	iacflags.AddTofuInitFlags(Outputs)
+	Outputs.PersistentFlags().String(string(flag.WriteStatus), "",
+		"write a copy of the manifest with status.outputs populated to this path")
+}
+
+func outputsHandler(cmd *cobra.Command, args []string) {
+	writeStatusPath, err := cmd.Flags().GetString(string(flag.WriteStatus))
+	flag.HandleFlagErr(err, flag.WriteStatus)
+
+	ctx, err := iacrunner.ResolveContext(cmd)
+	if err != nil {
+		// Only print error if it wasn't already handled (clipboard/manifest load errors are pre-handled)
+		if !climanifest.IsClipboardError(err) && !manifest.IsManifestLoadError(err) {
+			cliprint.PrintError(err.Error())
+		}
+		os.Exit(1)
+	}
+	defer ctx.Cleanup()
+
+	rawOutputs, err := iacrunner.ReadOutputs(ctx, cmd)
+	if err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
+	typedOutputs, err := stackoutputs.ToStackOutputs(ctx.ManifestObject, rawOutputs)
+	if err != nil {
+		cliprint.PrintError(fmt.Sprintf("failed to convert stack outputs: %v", err))
+		os.Exit(1)
+	}
+
+	if writeStatusPath != "" {
+		withStatus, err := stackoutputs.SetStatusOutputs(ctx.ManifestObject, typedOutputs)
+		if err != nil {
+			cliprint.PrintError(fmt.Sprintf("failed to set status.outputs: %v", err))
+			os.Exit(1)
+		}
+		if err := manifest.WriteToFile(withStatus, writeStatusPath); err != nil {
+			cliprint.PrintError(err.Error())
+			os.Exit(1)
+		}
+		cliprint.PrintSuccess(fmt.Sprintf("Wrote manifest with status.outputs to %s", writeStatusPath))
+		return
+	}
+
+	if err := manifest.Print(typedOutputs); err != nil {
+		cliprint.PrintError(fmt.Sprintf("failed to print stack outputs: %v", err))
+		os.Exit(1)
+	}
+}
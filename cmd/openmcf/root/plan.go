@@ -1,15 +1,19 @@
 package root
 
 import (
+	"fmt"
 	"os"
 
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
 	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/pulumi"
 	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
 	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
 	"github.com/plantonhq/openmcf/internal/cli/iacflags"
 	"github.com/plantonhq/openmcf/internal/cli/iacrunner"
 	climanifest "github.com/plantonhq/openmcf/internal/cli/manifest"
 	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/iac/plansummary"
 	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
 	"github.com/spf13/cobra"
 )
@@ -23,7 +27,14 @@ var Plan = &cobra.Command{
 
 If the provisioner label is not present, you will be prompted to select one interactively.
 
-This command has 'preview' as an alias for Pulumi-style experience.`,
+This command has 'preview' as an alias for Pulumi-style experience.
+
+With --output, the plan is not handed over to the provisioner's terminal UI. It is captured
+and printed as a provisioner-independent summary of the resources to create, update, replace
+and delete: 'json' for tooling, or 'markdown' for posting as a pull request comment.
+
+Before planning, the manifest is checked against the policies in --policy-dir (default
+~/.openmcf/policies). Violations stop the plan unless --skip-policy is set.`,
 	Example: `
 	# Preview changes with manifest file
 	openmcf plan -f manifest.yaml
@@ -41,6 +52,12 @@ This command has 'preview' as an alias for Pulumi-style experience.`,
 
 	# Preview destroy plan (Tofu)
 	openmcf plan -f manifest.yaml --destroy
+
+	# Write a markdown plan summary for a pull request comment
+	openmcf plan -f manifest.yaml --output markdown --output-file plan.md
+
+	# Print a JSON plan summary
+	openmcf plan -f manifest.yaml --output json
 	`,
 	Run: planHandler,
 }
@@ -52,9 +69,19 @@ func init() {
 	iacflags.AddPulumiFlags(Plan)
 	iacflags.AddTofuPlanFlags(Plan)
 	iacflags.AddTofuInitFlags(Plan)
+	iacflags.AddPolicyFlags(Plan)
+	Plan.PersistentFlags().String(string(flag.Output), "",
+		"print a plan summary instead of the provisioner output: json or markdown")
+	Plan.PersistentFlags().String(string(flag.OutputFile), "",
+		"file to write the plan summary to instead of stdout (with --output)")
 }
 
 func planHandler(cmd *cobra.Command, args []string) {
+	output, err := cmd.Flags().GetString(string(flag.Output))
+	flag.HandleFlagErr(err, flag.Output)
+	outputFile, err := cmd.Flags().GetString(string(flag.OutputFile))
+	flag.HandleFlagErr(err, flag.OutputFile)
+
 	ctx, err := iacrunner.ResolveContext(cmd)
 	if err != nil {
 		// Only print error if it wasn't already handled (clipboard/manifest load errors are pre-handled)
@@ -65,6 +92,24 @@ func planHandler(cmd *cobra.Command, args []string) {
 	}
 	defer ctx.Cleanup()
 
+	if err := iacrunner.ResolveReferences(ctx, cmd); err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
+	if output != "" {
+		summary, err := iacrunner.SummarizePlan(ctx, cmd)
+		if err != nil {
+			cliprint.PrintError(fmt.Sprintf("failed to plan: %v", err))
+			os.Exit(1)
+		}
+		if err := writePlanSummary(summary, plansummary.Format(output), outputFile); err != nil {
+			cliprint.PrintError(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	switch ctx.ProvisionerType {
 	case provisioner.ProvisionerTypePulumi:
 		// For preview, we use update operation with isPreview=true
@@ -84,3 +129,23 @@ func planHandler(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 }
+
+// writePlanSummary renders the summary to outputFile, or to stdout when no file is given.
+func writePlanSummary(summary *planv1.PlanSummary, format plansummary.Format, outputFile string) error {
+	if outputFile == "" {
+		return plansummary.Write(os.Stdout, summary, format)
+	}
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", outputFile, err)
+	}
+	if err := plansummary.Write(f, summary, format); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFile, err)
+	}
+	cliprint.PrintSuccess(fmt.Sprintf("Wrote plan summary to %s", outputFile))
+	return nil
+}
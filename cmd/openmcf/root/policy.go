@@ -0,0 +1,127 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/cli/iacrunner"
+	"github.com/plantonhq/openmcf/internal/policy"
+	"github.com/plantonhq/openmcf/internal/stackset"
+	"github.com/spf13/cobra"
+)
+
+var PolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "manage the policies manifests are checked against",
+	Long: `Policies are CEL rules evaluated against manifests before apply and plan hand them to
+the provisioner, e.g. "no public S3 buckets in prod" or "every resource needs an owner label".
+
+They are read from the YAML files in --policy-dir, or in ~/.openmcf/policies by default:
+
+  policies:
+    - name: owner-label
+      rule: '"owner" in metadata.labels'
+      message: every resource needs an owner label
+    - name: rds-multi-az-in-prod
+      match:
+        kinds: [AwsRdsInstance]
+        envs: [prod]
+      rule: spec.multiAz
+      severity: error   # or warning, which is reported but does not block
+
+Rules read apiVersion, kind, metadata, spec and status using the camelCase field names of
+the manifest YAML. Unset fields hold their zero value and unset messages are null.`,
+}
+
+var policyTestCmd = &cobra.Command{
+	Use:   "test <manifest-path>...",
+	Short: "evaluate the policies against manifests",
+	Long: `Evaluate the policies against manifests without running a provisioner. Each path can be a
+manifest file, a multi-document YAML file or a directory of manifests.
+
+Exits with a non-zero status if any manifest violates a policy of severity error.`,
+	Example: `
+	# Check a manifest against ~/.openmcf/policies
+	openmcf policy test manifest.yaml
+
+	# Check every manifest in a directory against the repository's policies
+	openmcf policy test ./infra/ --policy-dir ./policies
+
+	# Check the manifests as patched by their env/prod.yaml overlays
+	openmcf policy test ./infra/ --env prod
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  policyTestHandler,
+}
+
+func init() {
+	policyTestCmd.Flags().String(string(flag.PolicyDir), "",
+		"directory of policy files to evaluate manifests against (default ~/.openmcf/policies)")
+	policyTestCmd.Flags().String(string(flag.Env), "",
+		"apply the env/<env>.yaml patches next to the manifests before evaluating them")
+	PolicyCmd.AddCommand(policyTestCmd)
+}
+
+func policyTestHandler(cmd *cobra.Command, args []string) {
+	policies, err := iacrunner.LoadPolicies(cmd)
+	if err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+	if len(policies.Policies) == 0 {
+		cliprint.PrintError("No policies found; add policy files to ~/.openmcf/policies or pass --policy-dir")
+		os.Exit(1)
+	}
+	cliprint.PrintInfo(fmt.Sprintf("Loaded %d policies", len(policies.Policies)))
+
+	env, err := cmd.Flags().GetString(string(flag.Env))
+	flag.HandleFlagErr(err, flag.Env)
+
+	failed := false
+	for _, path := range args {
+		set, err := stackset.Load(path)
+		if err != nil {
+			cliprint.PrintError(fmt.Sprintf("Failed to load %s: %v", path, err))
+			os.Exit(1)
+		}
+		if env != "" {
+			if err := set.ApplyEnvOverlay(path, env); err != nil {
+				set.Cleanup()
+				cliprint.PrintError(fmt.Sprintf("Failed to apply env overlay to %s: %v", path, err))
+				os.Exit(1)
+			}
+		}
+		for _, m := range set.Manifests {
+			violations, err := policies.Evaluate(m.Object)
+			if err != nil {
+				set.Cleanup()
+				cliprint.PrintError(fmt.Sprintf("%s: %v", m.Source, err))
+				os.Exit(1)
+			}
+			printPolicyResult(m.Source, violations)
+			if policy.HasBlocking(violations) {
+				failed = true
+			}
+		}
+		set.Cleanup()
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func printPolicyResult(source string, violations []*policy.Violation) {
+	if len(violations) == 0 {
+		cliprint.PrintSuccess(source + ": passed")
+		return
+	}
+	for _, v := range violations {
+		if v.Blocking() {
+			cliprint.PrintError(fmt.Sprintf("%s: %s", source, v))
+		} else {
+			cliprint.PrintWarning(fmt.Sprintf("%s: %s", source, v))
+		}
+	}
+}
@@ -25,7 +25,7 @@ func init() {
 	Pulumi.PersistentFlags().String(string(flag.KustomizeDir), "", "directory containing kustomize configuration")
 	Pulumi.PersistentFlags().String(string(flag.Overlay), "", "kustomize overlay to use (e.g., prod, dev, staging)")
 	Pulumi.PersistentFlags().String(string(flag.ModuleDir), pwd, "directory containing the pulumi module")
-	Pulumi.PersistentFlags().StringToString(string(flag.Set), map[string]string{}, "override resource manifest values using key=value pairs")
+	Pulumi.PersistentFlags().Var(flag.NewSetValue(), string(flag.Set), "override resource manifest values using key=value pairs")
 
 	Pulumi.PersistentFlags().String(string(flag.Stack), "", "pulumi stack fqdn in the format of <org>/<project>/<stack>")
 	Pulumi.PersistentFlags().Bool(string(flag.Yes), false, "Automatically approve and perform the update after previewing it")
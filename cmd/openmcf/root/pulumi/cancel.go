@@ -31,7 +31,7 @@ func cancelHandler(cmd *cobra.Command, args []string) {
 	stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
 	flag.HandleFlagErr(err, flag.Stack)
 
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	flag.HandleFlagErr(err, flag.Set)
 
 	// Check which manifest source is being used for informative messages
@@ -69,6 +69,8 @@ func cancelHandler(cmd *cobra.Command, args []string) {
 	if isTempOverrides {
 		defer os.Remove(finalManifestPath)
 		targetManifestPath = finalManifestPath
+		// The overrides are now part of the manifest; applying them again would repeat [+] appends
+		valueOverrides = nil
 		cliprint.PrintSuccess("Overrides applied")
 	}
 
@@ -39,7 +39,7 @@ func deleteHandler(cmd *cobra.Command, args []string) {
 	stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
 	flag.HandleFlagErr(err, flag.Stack)
 
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	flag.HandleFlagErr(err, flag.Set)
 
 	force, err := cmd.Flags().GetBool(string(flag.Force))
@@ -80,6 +80,8 @@ func deleteHandler(cmd *cobra.Command, args []string) {
 	if isTempOverrides {
 		defer os.Remove(finalManifestPath)
 		targetManifestPath = finalManifestPath
+		// The overrides are now part of the manifest; applying them again would repeat [+] appends
+		valueOverrides = nil
 		cliprint.PrintSuccess("Overrides applied")
 	}
 
@@ -60,6 +60,11 @@ func refreshHandler(cmd *cobra.Command, args []string) {
 	}
 	defer ctx.Cleanup()
 
+	if err := iacrunner.ResolveReferences(ctx, cmd); err != nil {
+		cliprint.PrintError(err.Error())
+		os.Exit(1)
+	}
+
 	switch ctx.ProvisionerType {
 	case provisioner.ProvisionerTypePulumi:
 		if err := iacrunner.RunPulumi(ctx, cmd, pulumi.PulumiOperationType_refresh, false); err != nil {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -49,7 +48,7 @@ func stackUpdateStreamOutputHandler(cmd *cobra.Command, args []string) {
 
 	// Create Connect-RPC client
 	client := stackupdatev1connect.NewStackUpdateQueryControllerClient(
-		http.DefaultClient,
+		GetBackendHTTPClient(),
 		backendURL,
 	)
 
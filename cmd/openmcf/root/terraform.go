@@ -26,7 +26,7 @@ func init() {
 	Terraform.PersistentFlags().String(string(flag.KustomizeDir), "", "directory containing kustomize configuration")
 	Terraform.PersistentFlags().String(string(flag.Overlay), "", "kustomize overlay to use (e.g., prod, dev, staging)")
 	Terraform.PersistentFlags().String(string(flag.ModuleDir), pwd, "directory containing the terraform module")
-	Terraform.PersistentFlags().StringToString(string(flag.Set), map[string]string{}, "override resource manifest values using key=value pairs")
+	Terraform.PersistentFlags().Var(flag.NewSetValue(), string(flag.Set), "override resource manifest values using key=value pairs")
 
 	// Provider config flag (unified)
 	Terraform.PersistentFlags().StringP(string(flag.ProviderConfig), "p", "", "path to provider credentials file")
@@ -8,6 +8,7 @@ import (
 	tfpb "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
 	"github.com/plantonhq/openmcf/internal/cli/cliprint"
 	"github.com/plantonhq/openmcf/internal/cli/flag"
+	climanifest "github.com/plantonhq/openmcf/internal/cli/manifest"
 	"github.com/plantonhq/openmcf/internal/cli/ui"
 	"github.com/plantonhq/openmcf/internal/cli/workspace"
 	"github.com/plantonhq/openmcf/internal/manifest"
@@ -51,7 +52,7 @@ func initHandler(cmd *cobra.Command, args []string) {
 	moduleDir, err := cmd.Flags().GetString(string(flag.ModuleDir))
 	flag.HandleFlagErrAndValue(err, flag.ModuleDir, moduleDir)
 
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	flag.HandleFlagErr(err, flag.Set)
 
 	backendTypeString, err := cmd.Flags().GetString(string(flag.BackendType))
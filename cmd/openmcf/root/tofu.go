@@ -25,7 +25,7 @@ func init() {
 	Tofu.PersistentFlags().String(string(flag.KustomizeDir), "", "directory containing kustomize configuration")
 	Tofu.PersistentFlags().String(string(flag.Overlay), "", "kustomize overlay to use (e.g., prod, dev, staging)")
 	Tofu.PersistentFlags().String(string(flag.ModuleDir), pwd, "directory containing the terraform module")
-	Tofu.PersistentFlags().StringToString(string(flag.Set), map[string]string{}, "override resource manifest values using key=value pairs")
+	Tofu.PersistentFlags().Var(flag.NewSetValue(), string(flag.Set), "override resource manifest values using key=value pairs")
 
 	// Provider config flag (unified)
 	Tofu.PersistentFlags().StringP(string(flag.ProviderConfig), "p", "", "path to provider credentials file")
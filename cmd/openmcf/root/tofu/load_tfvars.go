@@ -19,7 +19,7 @@ var LoadTfVars = &cobra.Command{
 
 func loadTfVarsHandler(cmd *cobra.Command, args []string) {
 	manifestPath := args[0]
-	updatedManifest, err := manifest.LoadWithOverrides(manifestPath, map[string]string{})
+	updatedManifest, err := manifest.LoadWithOverrides(manifestPath, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
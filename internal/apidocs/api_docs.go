@@ -1,58 +1,93 @@
 package apidocs
 
 import (
+	_ "embed"
 	"encoding/json"
-	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/pkg/errors"
-	"github.com/plantonhq/openmcf/internal/cli/version"
 	"github.com/pseudomuto/protoc-gen-doc"
-	"io"
-	"net/http"
 )
 
-const downloadUrlFormatString = "https://github.com/plantonhq/openmcf/releases/download/%s/docs.json"
-const latestReleaseDownloadUrl = "https://github.com/plantonhq/openmcf/releases/latest/download/docs.json"
+// docsJson is the protoc-gen-doc JSON output for all apis, generated by `make protos`
+// alongside the go stubs, so field documentation is available without network access.
+//
+//go:embed docs.json
+var docsJson []byte
+
+var (
+	loadOnce sync.Once
+	apiDocs  *gendoc.Template
+	loadErr  error
+)
 
-// GetApiDocsJson downloads the docs.json from GitHub, parses it into a gendoc.Template, and returns it.
-// If docsJsonPath is not empty, you could alternatively load from a local file instead,
-// but here we focus on downloading from GitHub.
+// GetApiDocsJson parses the docs.json embedded in the binary into a gendoc.Template and returns it.
+// The template is parsed once and shared, so callers must not modify it.
 func GetApiDocsJson() (*gendoc.Template, error) {
-	var data []byte
-	var err error
+	loadOnce.Do(func() {
+		var tpl gendoc.Template
+		if err := json.Unmarshal(docsJson, &tpl); err != nil {
+			loadErr = errors.Wrap(err, "failed to unmarshal docs JSON into template")
+			return
+		}
+		apiDocs = &tpl
+	})
+	return apiDocs, loadErr
+}
 
-	data, err = downloadDocsJson()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to download docs JSON")
+// MessageDescription returns the documentation of a message, or "" if it is not documented.
+func MessageDescription(tpl *gendoc.Template, messageFullName string) string {
+	if m := findMessage(tpl, messageFullName); m != nil {
+		return strings.TrimSpace(m.Description)
 	}
+	return ""
+}
 
-	var tpl gendoc.Template
-	if err := json.Unmarshal(data, &tpl); err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal docs JSON into template")
+// FieldDescription returns the documentation of a message field, or "" if it is not documented.
+func FieldDescription(tpl *gendoc.Template, messageFullName, fieldName string) string {
+	m := findMessage(tpl, messageFullName)
+	if m == nil {
+		return ""
 	}
-
-	return &tpl, nil
+	for _, fld := range m.Fields {
+		if fld.Name == fieldName {
+			return strings.TrimSpace(fld.Description)
+		}
+	}
+	return ""
 }
 
-func downloadDocsJson() ([]byte, error) {
-	downloadUrl := latestReleaseDownloadUrl
-	if version.Version != version.DefaultVersion {
-		downloadUrl = fmt.Sprintf(downloadUrlFormatString, version.Version)
+// EnumValueDescription returns the documentation of an enum value, or "" if it is not documented.
+func EnumValueDescription(tpl *gendoc.Template, enumFullName, valueName string) string {
+	if tpl == nil {
+		return ""
 	}
-
-	resp, err := http.Get(downloadUrl)
-	if err != nil {
-		return nil, errors.Wrap(err, "http GET request failed")
+	for _, f := range tpl.Files {
+		for _, e := range f.Enums {
+			if e.FullName != enumFullName {
+				continue
+			}
+			for _, v := range e.Values {
+				if v.Name == valueName {
+					return strings.TrimSpace(v.Description)
+				}
+			}
+		}
 	}
-	defer resp.Body.Close()
+	return ""
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Wrapf(err, "unexpected status code: %d", resp.StatusCode)
+func findMessage(tpl *gendoc.Template, messageFullName string) *gendoc.Message {
+	if tpl == nil {
+		return nil
 	}
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
+	for _, f := range tpl.Files {
+		for _, m := range f.Messages {
+			if m.FullName == messageFullName {
+				return m
+			}
+		}
 	}
-
-	return data, nil
+	return nil
 }
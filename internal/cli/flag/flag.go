@@ -15,25 +15,36 @@ const (
 	BackendRegion   Flag = "backend-region"
 	BackendType     Flag = "backend-type"
 	Clipboard       Flag = "clipboard"
+	Concurrency     Flag = "concurrency"
 	Destroy         Flag = "destroy"
 	Diff            Flag = "diff"
+	Env             Flag = "env"
 	Force           Flag = "force"
+	Format          Flag = "format"
 	InputDir        Flag = "input-dir"
+	Interactive     Flag = "interactive"
 	KubeContext     Flag = "kube-context"
 	KustomizeDir    Flag = "kustomize-dir"
 	LocalModule     Flag = "local-module"
 	Manifest        Flag = "manifest"
 	ModuleDir       Flag = "module-dir"
 	ModuleVersion   Flag = "module-version"
+	Name            Flag = "name"
 	NoCleanup       Flag = "no-cleanup"
+	Org             Flag = "org"
+	Output          Flag = "output"
 	OutputFile      Flag = "output-file"
 	Overlay         Flag = "overlay"
 	OpenMCFGitRepo  Flag = "openmcf-git-repo"
+	PolicyDir       Flag = "policy-dir"
 	ProviderConfig  Flag = "provider-config"
 	Reconfigure     Flag = "reconfigure"
+	Recursive       Flag = "recursive"
 	Set             Flag = "set"
+	SkipPolicy      Flag = "skip-policy"
 	Stack           Flag = "stack"
 	StackInput      Flag = "stack-input"
+	WriteStatus     Flag = "write-status"
 	Yes             Flag = "yes"
 )
 
@@ -0,0 +1,91 @@
+package flag
+
+import (
+	"encoding/csv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// SetValue is the pflag.Value of the --set flag. It parses key=value pairs like pflag's
+// StringToString flag and can be read back with GetStringToString, but keeps values that
+// contain commas, brackets or quotes intact, e.g. --set 'spec.zones=[a, b]'.
+// Pairs are kept in command-line order, including repeated keys, so that overrides like
+// spec.zones[+]=a and spec.zones[+]=b are applied in sequence; read them with GetSetPairs.
+type SetValue struct {
+	pairs   []SetPair
+	changed bool
+}
+
+// SetPair is one key=value pair of the --set flag.
+type SetPair struct {
+	Key   string
+	Value string
+}
+
+// NewSetValue returns an empty SetValue.
+func NewSetValue() *SetValue {
+	return &SetValue{}
+}
+
+// GetSetPairs returns the pairs of the --set flag in command-line order.
+func GetSetPairs(flags *pflag.FlagSet) ([]SetPair, error) {
+	f := flags.Lookup(string(Set))
+	if f == nil {
+		return nil, errors.Errorf("flag --%s is not defined", Set)
+	}
+	value, ok := f.Value.(*SetValue)
+	if !ok {
+		return nil, errors.Errorf("flag --%s is not a set flag", Set)
+	}
+	return append([]SetPair(nil), value.pairs...), nil
+}
+
+// Set adds the pairs of one occurrence of the flag. A value holding several pairs
+// separated by commas (a=1,b=2) is split; anything else is a single pair.
+func (s *SetValue) Set(val string) error {
+	records := []string{val}
+	if strings.Count(val, "=") > 1 {
+		if parsed, err := csv.NewReader(strings.NewReader(val)).Read(); err == nil && allPairs(parsed) {
+			records = parsed
+		}
+	}
+	if !s.changed {
+		s.pairs = nil
+		s.changed = true
+	}
+	for _, record := range records {
+		key, value, found := strings.Cut(record, "=")
+		if !found || key == "" {
+			return errors.Errorf("%s must be formatted as key=value", record)
+		}
+		s.pairs = append(s.pairs, SetPair{Key: key, Value: value})
+	}
+	return nil
+}
+
+func allPairs(records []string) bool {
+	for _, record := range records {
+		if !strings.Contains(record, "=") {
+			return false
+		}
+	}
+	return true
+}
+
+// Type reports the same type as pflag's StringToString flag so GetStringToString reads it.
+func (s *SetValue) Type() string {
+	return "stringToString"
+}
+
+// String renders the pairs as a bracketed CSV record in command-line order. Every record
+// is quoted so that GetStringToString, which trims brackets before parsing, returns the
+// values unchanged; for a repeated key it keeps the last value.
+func (s *SetValue) String() string {
+	records := make([]string, 0, len(s.pairs))
+	for _, pair := range s.pairs {
+		records = append(records, `"`+strings.ReplaceAll(pair.Key+"="+pair.Value, `"`, `""`)+`"`)
+	}
+	return "[" + strings.Join(records, ",") + "]"
+}
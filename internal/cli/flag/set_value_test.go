@@ -0,0 +1,110 @@
+package flag
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValue(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want map[string]string
+	}{
+		{
+			name: "single pair",
+			args: []string{"--set", "spec.replicas=3"},
+			want: map[string]string{"spec.replicas": "3"},
+		},
+		{
+			name: "comma separated pairs",
+			args: []string{"--set", "spec.replicas=3,spec.name=web"},
+			want: map[string]string{"spec.replicas": "3", "spec.name": "web"},
+		},
+		{
+			name: "list literal",
+			args: []string{"--set", "spec.zones=[a, b]"},
+			want: map[string]string{"spec.zones": "[a, b]"},
+		},
+		{
+			name: "value ending with a bracket",
+			args: []string{"--set", "spec.zones=[a]", "--set", "spec.name=web"},
+			want: map[string]string{"spec.zones": "[a]", "spec.name": "web"},
+		},
+		{
+			name: "object literal with equals signs and quotes",
+			args: []string{"--set", `spec.env={"A": "x=1", "B": "y"}`},
+			want: map[string]string{"spec.env": `{"A": "x=1", "B": "y"}`},
+		},
+		{
+			name: "repeated flag",
+			args: []string{"--set", "a=1", "--set", "b=2"},
+			want: map[string]string{"a": "1", "b": "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.Var(NewSetValue(), string(Set), "")
+			require.NoError(t, flags.Parse(tt.args))
+
+			got, err := flags.GetStringToString(string(Set))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetValueRejectsMissingKey(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Var(NewSetValue(), string(Set), "")
+	assert.Error(t, flags.Parse([]string{"--set", "spec.replicas"}))
+}
+
+func TestSetValueDefault(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Var(NewSetValue(), string(Set), "")
+	require.NoError(t, flags.Parse(nil))
+
+	got, err := flags.GetStringToString(string(Set))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestGetSetPairs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []SetPair
+	}{
+		{
+			name: "repeated append keeps every value in order",
+			args: []string{"--set", "spec.zones[+]=a", "--set", "spec.zones[+]=b", "--set", "spec.zones[+]=c"},
+			want: []SetPair{{"spec.zones[+]", "a"}, {"spec.zones[+]", "b"}, {"spec.zones[+]", "c"}},
+		},
+		{
+			name: "mixed index and append keep command-line order",
+			args: []string{"--set", "spec.zones[10]=x", "--set", "spec.zones[+]=y", "--set", "spec.zones[2]=z"},
+			want: []SetPair{{"spec.zones[10]", "x"}, {"spec.zones[+]", "y"}, {"spec.zones[2]", "z"}},
+		},
+		{
+			name: "clear then set on the same path",
+			args: []string{"--set", "spec.zones=null,spec.zones[+]=a"},
+			want: []SetPair{{"spec.zones", "null"}, {"spec.zones[+]", "a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.Var(NewSetValue(), string(Set), "")
+			require.NoError(t, flags.Parse(tt.args))
+
+			got, err := GetSetPairs(flags)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -28,7 +28,7 @@ func AddExecutionFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(string(flag.KubeContext), "",
 		"kubectl context to use for Kubernetes deployments (overrides manifest label)")
 
-	cmd.PersistentFlags().StringToString(string(flag.Set), map[string]string{},
+	cmd.PersistentFlags().Var(flag.NewSetValue(), string(flag.Set),
 		"override resource manifest values using key=value pairs")
 
 	cmd.PersistentFlags().Bool(string(flag.LocalModule), false,
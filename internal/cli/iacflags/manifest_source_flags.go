@@ -39,6 +39,9 @@ func AddManifestSourceFlags(cmd *cobra.Command) {
 
 	cmd.PersistentFlags().String(string(flag.Overlay), "",
 		"kustomize overlay to use (e.g., prod, dev, staging)")
+
+	cmd.PersistentFlags().String(string(flag.Env), "",
+		"apply the env/<env>.yaml patches next to the manifest and set metadata.env (e.g., prod)")
 }
 
 // IsClipboardFlagSet checks if any clipboard flag (--clipboard, --clip, or --cb) is set.
@@ -0,0 +1,15 @@
+package iacflags
+
+import (
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/spf13/cobra"
+)
+
+// AddPolicyFlags adds flags for the policy gate. Commands with these flags evaluate the
+// policies against each manifest before handing it to the provisioner.
+func AddPolicyFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(string(flag.PolicyDir), "",
+		"directory of policy files to evaluate manifests against (default ~/.openmcf/policies)")
+	cmd.PersistentFlags().Bool(string(flag.SkipPolicy), false,
+		"report policy violations as warnings instead of stopping")
+}
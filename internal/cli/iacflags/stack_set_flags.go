@@ -0,0 +1,16 @@
+package iacflags
+
+import (
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/spf13/cobra"
+)
+
+// DefaultStackSetConcurrency is the default number of stack set manifests run in parallel.
+const DefaultStackSetConcurrency = 4
+
+// AddStackSetFlags adds flags that control stack set execution, i.e. when --manifest points
+// at a directory or a multi-document YAML file.
+func AddStackSetFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Int(string(flag.Concurrency), DefaultStackSetConcurrency,
+		"maximum number of independent manifests to run in parallel when --manifest is a directory or multi-document file")
+}
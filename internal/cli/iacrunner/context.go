@@ -4,12 +4,14 @@ import (
 	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
 	"github.com/plantonhq/openmcf/pkg/iac/stackinput/providerdetect"
 	"github.com/plantonhq/openmcf/pkg/iac/stackinput/stackinputproviderconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
 	"google.golang.org/protobuf/proto"
 )
 
 // Context holds all resolved inputs needed for IaC command execution.
 type Context struct {
-	// ManifestPath is the path to the resolved manifest file
+	// ManifestPath is the path to the resolved manifest file, with any --set overrides
+	// already applied. Provisioners must not apply the overrides again.
 	ManifestPath string
 
 	// ManifestObject is the loaded and validated manifest proto message
@@ -33,9 +35,6 @@ type Context struct {
 	// ModuleDir is the directory containing the provisioner module
 	ModuleDir string
 
-	// ValueOverrides contains key=value pairs for manifest field overrides
-	ValueOverrides map[string]string
-
 	// ModuleVersion is the specific version to checkout for IaC modules
 	ModuleVersion string
 
@@ -45,6 +44,10 @@ type Context struct {
 	// ShowDiff indicates whether to show detailed resource diffs
 	ShowDiff bool
 
+	// BackendConfig is the pre-resolved Tofu/Terraform backend configuration.
+	// When nil, it is built from CLI flags and manifest labels at execution time.
+	BackendConfig *backendconfig.TofuBackendConfig
+
 	// CleanupFuncs contains functions to run after execution for cleanup
 	CleanupFuncs []func()
 }
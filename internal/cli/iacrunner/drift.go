@@ -0,0 +1,83 @@
+package iacrunner
+
+import (
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/pkg/iac/drift"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumistack"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tofumodule"
+	"github.com/spf13/cobra"
+)
+
+// DetectDrift compares the live infrastructure of the context's resource with its expected state
+// without changing either. Pulumi runs `pulumi preview --refresh --json`; Tofu and Terraform run
+// a refresh-only plan.
+func DetectDrift(ctx *Context, cmd *cobra.Command) (*drift.Report, error) {
+	id, err := ResourceID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to identify resource")
+	}
+	report := &drift.Report{Resource: id, Provisioner: ctx.ProvisionerType.String()}
+
+	switch ctx.ProvisionerType {
+	case provisioner.ProvisionerTypePulumi:
+		stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get stack flag")
+		}
+		cliprint.PrintStep("Running pulumi preview --refresh...")
+		previewJson, err := pulumistack.PreviewJson(
+			ctx.ModuleDir,
+			stackFqdn,
+			ctx.ManifestPath,
+			nil,
+			ctx.ModuleVersion,
+			ctx.NoCleanup,
+			ctx.KubeContext,
+			ctx.StackInputFilePath,
+			ctx.ProviderConfig,
+			true,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if report.Drifted, err = drift.ParsePulumiPreview(previewJson); err != nil {
+			return nil, err
+		}
+	case provisioner.ProvisionerTypeTofu, provisioner.ProvisionerTypeTerraform:
+		binary := hclBinary(ctx.ProvisionerType)
+		if err := binary.CheckAvailable(); err != nil {
+			return nil, err
+		}
+		backendCfg := ctx.BackendConfig
+		if backendCfg == nil {
+			backendCfg, err = buildAndValidateBackendConfig(ctx, cmd, binary.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+		cliprint.PrintStep("Running " + binary.String() + " plan -refresh-only...")
+		planJson, err := tofumodule.RefreshOnlyPlanJson(
+			binary.String(),
+			ctx.ModuleDir,
+			ctx.ManifestPath,
+			nil,
+			ctx.ModuleVersion,
+			ctx.NoCleanup,
+			ctx.KubeContext,
+			ctx.ProviderConfig,
+			backendCfg,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if report.Drifted, err = drift.ParseTofuPlan(planJson); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unknown provisioner type")
+	}
+	return report, nil
+}
@@ -0,0 +1,129 @@
+package iacrunner
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/stackoutputs"
+	"github.com/plantonhq/openmcf/internal/stackset"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumistack"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tofumodule"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"github.com/spf13/cobra"
+)
+
+// ResourceID returns the ID of the context's resource, e.g. "GcpProject/prod/main".
+// It matches the stack set node ID and keys the local outputs cache.
+func ResourceID(ctx *Context) (string, error) {
+	kindName, err := crkreflect.ExtractKindFromProto(ctx.ManifestObject)
+	if err != nil {
+		return "", err
+	}
+	metadata := metadatareflect.ExtractMetadata(ctx.ManifestObject)
+	if metadata == nil || metadata.Name == "" {
+		return "", errors.New("manifest has no metadata.name")
+	}
+	return stackset.NodeID(kindName, metadata.Env, metadata.Name), nil
+}
+
+// ReadOutputs reads the current stack outputs of the context's resource from its provisioner's
+// state and records them in the local outputs cache.
+func ReadOutputs(ctx *Context, cmd *cobra.Command) (map[string]interface{}, error) {
+	outputs, err := fetchStackOutputs(ctx, cmd)
+	if err != nil {
+		if id, idErr := ResourceID(ctx); idErr == nil {
+			return nil, errors.Wrapf(err, "failed to read stack outputs of %s", id)
+		}
+		return nil, errors.Wrap(err, "failed to read stack outputs")
+	}
+	if err := saveOutputs(ctx, cmd, outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// saveOutputs records the outputs of the context's resource, and where its state lives, in the
+// local outputs cache.
+func saveOutputs(ctx *Context, cmd *cobra.Command, outputs map[string]interface{}) error {
+	id, err := ResourceID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to identify resource")
+	}
+	if err := stackoutputs.Save(id, outputs, stateLocator(ctx, cmd)); err != nil {
+		return errors.Wrapf(err, "failed to cache stack outputs of %s", id)
+	}
+	return nil
+}
+
+// stateLocator returns where the state of the context's resource lives, so that references from
+// other manifests can read its outputs. A local Tofu/Terraform state is only reachable through
+// the staged module, so it has no locator.
+func stateLocator(ctx *Context, cmd *cobra.Command) *stackoutputs.StateLocator {
+	switch ctx.ProvisionerType {
+	case provisioner.ProvisionerTypePulumi:
+		return &stackoutputs.StateLocator{Provisioner: ctx.ProvisionerType.String(), PulumiStack: pulumiStackFqdn(ctx, cmd)}
+	case provisioner.ProvisionerTypeTofu, provisioner.ProvisionerTypeTerraform:
+		backendCfg, err := hclBackendConfig(ctx, cmd)
+		if err != nil || backendCfg == nil || backendCfg.BackendType == "" || backendCfg.BackendType == "local" {
+			return nil
+		}
+		return &stackoutputs.StateLocator{Provisioner: ctx.ProvisionerType.String(), Backend: backendCfg}
+	default:
+		return nil
+	}
+}
+
+// readStateOutputs reads the current outputs from the state a locator points at.
+func readStateOutputs(state *stackoutputs.StateLocator) (map[string]interface{}, error) {
+	switch state.Provisioner {
+	case provisioner.ProvisionerTypePulumi.String():
+		return pulumistack.GetOutputs(state.PulumiStack)
+	case provisioner.ProvisionerTypeTofu.String(), provisioner.ProvisionerTypeTerraform.String():
+		if state.Backend == nil {
+			return nil, errors.New("no state backend recorded")
+		}
+		return tofumodule.ReadBackendOutputs(state.Provisioner, state.Backend)
+	default:
+		return nil, errors.Errorf("unknown provisioner %q", state.Provisioner)
+	}
+}
+
+// captureOutputs records the stack outputs after a successful apply or refresh. The operation
+// itself already succeeded, so failures are reported as warnings.
+func captureOutputs(ctx *Context, cmd *cobra.Command) {
+	cliprint.PrintStep("Capturing stack outputs...")
+	outputs, err := ReadOutputs(ctx, cmd)
+	reportCapturedOutputs(outputs, err)
+}
+
+// captureReadOutputs is like captureOutputs for outputs the operation already read, or failed
+// to read, from its initialized module.
+func captureReadOutputs(ctx *Context, cmd *cobra.Command, outputs map[string]interface{}, readErr error) {
+	cliprint.PrintStep("Capturing stack outputs...")
+	if readErr == nil {
+		readErr = saveOutputs(ctx, cmd, outputs)
+	}
+	reportCapturedOutputs(outputs, readErr)
+}
+
+func reportCapturedOutputs(outputs map[string]interface{}, err error) {
+	if err != nil {
+		cliprint.PrintWarning(fmt.Sprintf("Stack outputs were not captured: %s", err))
+		return
+	}
+	cliprint.PrintSuccess(fmt.Sprintf("Captured %d stack output(s)", len(outputs)))
+}
+
+// forgetOutputs drops the cached outputs of a destroyed resource.
+func forgetOutputs(ctx *Context) {
+	id, err := ResourceID(ctx)
+	if err != nil {
+		return
+	}
+	if err := stackoutputs.Remove(id); err != nil {
+		cliprint.PrintWarning(fmt.Sprintf("Cached stack outputs were not removed: %s", err))
+	}
+}
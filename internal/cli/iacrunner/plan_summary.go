@@ -0,0 +1,86 @@
+package iacrunner
+
+import (
+	"github.com/pkg/errors"
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/pkg/iac/plansummary"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumistack"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tofumodule"
+	"github.com/spf13/cobra"
+)
+
+// SummarizePlan plans the context's resource without handing the terminal to the provisioner and
+// returns the plan as a PlanSummary. Pulumi runs `pulumi preview --json`; Tofu and Terraform save
+// a plan and read it back with `show -json`.
+func SummarizePlan(ctx *Context, cmd *cobra.Command) (*planv1.PlanSummary, error) {
+	id, err := ResourceID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to identify resource")
+	}
+	summary := &planv1.PlanSummary{Resource: id, Provisioner: ctx.ProvisionerType.String()}
+
+	switch ctx.ProvisionerType {
+	case provisioner.ProvisionerTypePulumi:
+		stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get stack flag")
+		}
+		cliprint.PrintStep("Running pulumi preview...")
+		previewJson, err := pulumistack.PreviewJson(
+			ctx.ModuleDir,
+			stackFqdn,
+			ctx.ManifestPath,
+			nil,
+			ctx.ModuleVersion,
+			ctx.NoCleanup,
+			ctx.KubeContext,
+			ctx.StackInputFilePath,
+			ctx.ProviderConfig,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if summary.Changes, err = plansummary.ParsePulumiPreview(previewJson); err != nil {
+			return nil, err
+		}
+	case provisioner.ProvisionerTypeTofu, provisioner.ProvisionerTypeTerraform:
+		binary := hclBinary(ctx.ProvisionerType)
+		if err := binary.CheckAvailable(); err != nil {
+			return nil, err
+		}
+		backendCfg := ctx.BackendConfig
+		if backendCfg == nil {
+			backendCfg, err = buildAndValidateBackendConfig(ctx, cmd, binary.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+		isDestroyPlan, _ := cmd.Flags().GetBool(string(flag.Destroy))
+		cliprint.PrintStep("Running " + binary.String() + " plan...")
+		planJson, err := tofumodule.PlanJson(
+			binary.String(),
+			ctx.ModuleDir,
+			ctx.ManifestPath,
+			nil,
+			isDestroyPlan,
+			ctx.ModuleVersion,
+			ctx.NoCleanup,
+			ctx.KubeContext,
+			ctx.ProviderConfig,
+			backendCfg,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if summary.Changes, err = plansummary.ParseTofuPlan(planJson); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unknown provisioner type")
+	}
+	return summary, nil
+}
@@ -0,0 +1,73 @@
+package iacrunner
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/policy"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// checkPolicies evaluates the configured policies against the manifest. It only runs for
+// commands that register the policy flags (apply and plan). Blocking violations fail the
+// command unless --skip-policy is set, in which case they are reported as warnings.
+func checkPolicies(cmd *cobra.Command, manifestObject proto.Message) error {
+	if cmd.Flags().Lookup(string(flag.PolicyDir)) == nil {
+		return nil
+	}
+	policies, err := LoadPolicies(cmd)
+	if err != nil {
+		return err
+	}
+	if len(policies.Policies) == 0 {
+		return nil
+	}
+
+	cliprint.PrintStep(fmt.Sprintf("Evaluating %d policies...", len(policies.Policies)))
+	violations, err := policies.Evaluate(manifestObject)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate policies")
+	}
+	if len(violations) == 0 {
+		cliprint.PrintSuccess("Policies passed")
+		return nil
+	}
+
+	skipPolicy, _ := cmd.Flags().GetBool(string(flag.SkipPolicy))
+	for _, v := range violations {
+		if v.Blocking() && !skipPolicy {
+			cliprint.PrintError(v.String())
+		} else {
+			cliprint.PrintWarning(v.String())
+		}
+	}
+	if !policy.HasBlocking(violations) {
+		return nil
+	}
+	if skipPolicy {
+		cliprint.PrintWarning("Continuing despite policy violations because of --skip-policy")
+		return nil
+	}
+	return errors.New("manifest violates policies; fix the manifest or pass --skip-policy to override")
+}
+
+// LoadPolicies reads the policies from --policy-dir or, if it is not set, from
+// ~/.openmcf/policies. A missing default directory yields no policies.
+func LoadPolicies(cmd *cobra.Command) (*policy.Set, error) {
+	dir, _ := cmd.Flags().GetString(string(flag.PolicyDir))
+	if dir != "" {
+		return policy.LoadDir(dir)
+	}
+	dir, err := policy.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return &policy.Set{}, nil
+	}
+	return policy.LoadDir(dir)
+}
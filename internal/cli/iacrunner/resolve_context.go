@@ -22,6 +22,16 @@ import (
 // ResolveContext reads command flags, resolves manifest from various sources,
 // validates the manifest, detects provisioner, and returns a ready-to-execute Context.
 func ResolveContext(cmd *cobra.Command) (*Context, error) {
+	return resolveContext(cmd, "")
+}
+
+// ResolveContextForManifest behaves like ResolveContext but uses the given manifest path
+// instead of resolving one from the manifest source flags. It is used for each manifest of a stack set.
+func ResolveContextForManifest(cmd *cobra.Command, manifestPath string) (*Context, error) {
+	return resolveContext(cmd, manifestPath)
+}
+
+func resolveContext(cmd *cobra.Command, manifestPath string) (*Context, error) {
 	ctx := &Context{}
 
 	// Get module directory
@@ -35,39 +45,42 @@ func ResolveContext(cmd *cobra.Command) (*Context, error) {
 	ctx.ModuleDir = moduleDir
 
 	// Get value overrides
-	valueOverrides, err := cmd.Flags().GetStringToString(string(flag.Set))
+	valueOverrides, err := climanifest.GetOverrides(cmd)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get set flag")
+		return nil, err
 	}
-	ctx.ValueOverrides = valueOverrides
 
-	// Check which manifest source is being used for informative messages
-	kustomizeDir, _ := cmd.Flags().GetString(string(flag.KustomizeDir))
-	overlay, _ := cmd.Flags().GetString(string(flag.Overlay))
+	targetManifestPath := manifestPath
+	if targetManifestPath == "" {
+		// Check which manifest source is being used for informative messages
+		kustomizeDir, _ := cmd.Flags().GetString(string(flag.KustomizeDir))
+		overlay, _ := cmd.Flags().GetString(string(flag.Overlay))
 
-	if kustomizeDir != "" && overlay != "" {
-		cliprint.PrintStep(fmt.Sprintf("Building manifest from kustomize overlay: %s", overlay))
-	} else {
-		cliprint.PrintStep("Loading manifest...")
-	}
+		if kustomizeDir != "" && overlay != "" {
+			cliprint.PrintStep(fmt.Sprintf("Building manifest from kustomize overlay: %s", overlay))
+		} else {
+			cliprint.PrintStep("Loading manifest...")
+		}
 
-	// Resolve manifest path with priority: --stack-input > --manifest > --input-dir > --kustomize-dir + --overlay
-	targetManifestPath, isTemp, err := climanifest.ResolveManifestPath(cmd)
-	if err != nil {
-		// Check for clipboard-specific errors and display beautifully
-		if climanifest.HandleClipboardError(err) {
-			// Return error to signal failure, but error display already handled
-			return nil, err
+		// Resolve manifest path with priority: --stack-input > --manifest > --input-dir > --kustomize-dir + --overlay
+		resolvedPath, isTemp, err := climanifest.ResolveManifestPath(cmd)
+		if err != nil {
+			// Check for clipboard-specific errors and display beautifully
+			if climanifest.HandleClipboardError(err) {
+				// Return error to signal failure, but error display already handled
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "failed to resolve manifest")
 		}
-		return nil, errors.Wrap(err, "failed to resolve manifest")
-	}
-	if isTemp {
-		ctx.AddCleanupFunc(func() { os.Remove(targetManifestPath) })
+		if isTemp {
+			ctx.AddCleanupFunc(func() { os.Remove(resolvedPath) })
+		}
+		targetManifestPath = resolvedPath
+
+		cliprint.PrintSuccess("Manifest loaded")
 	}
 	ctx.ManifestPath = targetManifestPath
 
-	cliprint.PrintSuccess("Manifest loaded")
-
 	// Apply value overrides if any (creates new temp file if overrides exist)
 	if len(valueOverrides) > 0 {
 		cliprint.PrintStep(fmt.Sprintf("Applying %d field override(s)...", len(valueOverrides)))
@@ -94,9 +107,9 @@ func ResolveContext(cmd *cobra.Command) (*Context, error) {
 	}
 	cliprint.PrintSuccess("Manifest validated")
 
-	// Load manifest to extract provisioner
+	// Load manifest to extract provisioner. The overrides are already part of ctx.ManifestPath.
 	cliprint.PrintStep("Detecting provisioner...")
-	manifestObject, err := manifest.LoadWithOverrides(ctx.ManifestPath, valueOverrides)
+	manifestObject, err := manifest.LoadWithOverrides(ctx.ManifestPath, nil)
 	if err != nil {
 		// Check for manifest load errors (proto unmarshaling) and display beautifully
 		if manifest.HandleManifestLoadError(err) {
@@ -106,6 +119,10 @@ func ResolveContext(cmd *cobra.Command) (*Context, error) {
 	}
 	ctx.ManifestObject = manifestObject
 
+	if err := checkPolicies(cmd, manifestObject); err != nil {
+		return nil, err
+	}
+
 	// Extract provisioner from manifest
 	provType, err := provisioner.ExtractFromManifest(manifestObject)
 	if err != nil {
@@ -204,6 +221,7 @@ func ResolveContext(cmd *cobra.Command) (*Context, error) {
 	}
 
 	ctx.ProviderConfig = providerConfig
+
 	cliprint.PrintSuccess("Execution prepared")
 
 	return ctx, nil
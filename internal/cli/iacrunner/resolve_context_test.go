@@ -0,0 +1,97 @@
+package iacrunner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/plantonhq/openmcf/internal/cli/iacflags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+const testVpcManifest = `apiVersion: aws.openmcf.org/v1
+kind: AwsVpc
+metadata:
+  name: main
+  labels:
+    openmcf.org/provisioner: tofu
+spec:
+  vpcCidr: 10.0.0.0/16
+  subnetsPerAvailabilityZone: 1
+  subnetSize: 256
+  availabilityZones:
+    - us-east-1a
+`
+
+// newTestCommand returns a command with the flags of apply, parsed from args.
+func newTestCommand(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "apply", Run: func(*cobra.Command, []string) {}}
+	iacflags.AddManifestSourceFlags(cmd)
+	iacflags.AddProviderConfigFlags(cmd)
+	iacflags.AddExecutionFlags(cmd)
+	iacflags.AddPolicyFlags(cmd)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return cmd
+}
+
+func writeTestManifest(t *testing.T) string {
+	manifestPath := filepath.Join(t.TempDir(), "vpc.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(testVpcManifest), 0o600))
+	return manifestPath
+}
+
+func TestResolveContext_AppliesOverridesOnce(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cmd := newTestCommand(t,
+		"--manifest", writeTestManifest(t),
+		"--set", "spec.availabilityZones[+]=us-east-1b",
+		"--set", "spec.availabilityZones[+]=us-east-1c",
+		"--set", "spec.availabilityZones[0]=us-east-1d",
+	)
+
+	ctx, err := ResolveContext(cmd)
+	require.NoError(t, err)
+	defer ctx.Cleanup()
+
+	want := []string{"us-east-1d", "us-east-1b", "us-east-1c"}
+	assert.Equal(t, want, ctx.ManifestObject.(*awsvpcv1.AwsVpc).Spec.AvailabilityZones)
+
+	written, err := os.ReadFile(ctx.ManifestPath)
+	require.NoError(t, err)
+	var onDisk struct {
+		Spec struct {
+			AvailabilityZones []string `json:"availabilityZones"`
+		} `json:"spec"`
+	}
+	require.NoError(t, yaml.Unmarshal(written, &onDisk))
+	assert.Equal(t, want, onDisk.Spec.AvailabilityZones)
+}
+
+func TestResolveContext_PolicyGate(t *testing.T) {
+	policyDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "vpc.yaml"), []byte(`
+policies:
+  - name: nat-gateway
+    match:
+      kinds: [AwsVpc]
+    rule: spec.isNatGatewayEnabled
+`), 0o600))
+	manifestPath := writeTestManifest(t)
+
+	_, err := ResolveContext(newTestCommand(t, "--manifest", manifestPath, "--policy-dir", policyDir))
+	assert.ErrorContains(t, err, "violates policies")
+
+	ctx, err := ResolveContext(newTestCommand(t, "--manifest", manifestPath, "--policy-dir", policyDir, "--skip-policy"))
+	require.NoError(t, err)
+	ctx.Cleanup()
+
+	ctx, err = ResolveContext(newTestCommand(t, "--manifest", manifestPath, "--policy-dir", policyDir,
+		"--set", "spec.isNatGatewayEnabled=true"))
+	require.NoError(t, err)
+	ctx.Cleanup()
+}
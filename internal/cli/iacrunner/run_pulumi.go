@@ -1,8 +1,6 @@
 package iacrunner
 
 import (
-	"os"
-
 	"github.com/pkg/errors"
 	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/pulumi"
 	"github.com/plantonhq/openmcf/internal/cli/cliprint"
@@ -13,17 +11,21 @@ import (
 
 // RunPulumi executes a Pulumi operation using the resolved context.
 func RunPulumi(ctx *Context, cmd *cobra.Command, operation pulumi.PulumiOperationType, isPreview bool) error {
-	// Stack can be provided via flag or extracted from manifest
-	stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
-	if err != nil {
-		return errors.Wrap(err, "failed to get stack flag")
-	}
-
 	// Get auto-approve behavior
 	isAutoApprove := !isPreview
 	if yes, _ := cmd.Flags().GetBool(string(flag.Yes)); yes {
 		isAutoApprove = true
 	}
+	return runPulumi(ctx, cmd, operation, isPreview, isAutoApprove)
+}
+
+// runPulumi executes a Pulumi operation, asking for approval on the terminal unless isAutoApprove is set.
+func runPulumi(ctx *Context, cmd *cobra.Command, operation pulumi.PulumiOperationType, isPreview, isAutoApprove bool) error {
+	// Stack can be provided via flag or extracted from manifest
+	stackFqdn, err := cmd.Flags().GetString(string(flag.Stack))
+	if err != nil {
+		return errors.Wrap(err, "failed to get stack flag")
+	}
 
 	err = pulumistack.Run(
 		ctx.ModuleDir,
@@ -32,7 +34,7 @@ func RunPulumi(ctx *Context, cmd *cobra.Command, operation pulumi.PulumiOperatio
 		operation,
 		isPreview,
 		isAutoApprove,
-		ctx.ValueOverrides,
+		nil,
 		ctx.ShowDiff,
 		ctx.ModuleVersion,
 		ctx.NoCleanup,
@@ -42,8 +44,18 @@ func RunPulumi(ctx *Context, cmd *cobra.Command, operation pulumi.PulumiOperatio
 	)
 	if err != nil {
 		cliprint.PrintPulumiFailure()
-		os.Exit(1)
+		return err
 	}
 	cliprint.PrintPulumiSuccess()
+
+	if isPreview {
+		return nil
+	}
+	switch operation {
+	case pulumi.PulumiOperationType_update, pulumi.PulumiOperationType_refresh:
+		captureOutputs(ctx, cmd)
+	case pulumi.PulumiOperationType_destroy:
+		forgetOutputs(ctx)
+	}
 	return nil
 }
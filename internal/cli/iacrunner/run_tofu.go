@@ -2,7 +2,6 @@ package iacrunner
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
 	"github.com/plantonhq/openmcf/internal/cli/cliprint"
@@ -17,14 +16,14 @@ import (
 
 // RunTofu executes an OpenTofu operation using the resolved context.
 func RunTofu(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOperationType) error {
-	return runHcl(ctx, cmd, operation, provisioner.HclBinaryTofu)
-}
-
-// runHcl executes an HCL-based IaC operation (tofu or terraform) using the resolved context.
-func runHcl(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOperationType, binary provisioner.HclBinary) error {
 	// Get auto-approve flag if defined (ignore error for commands that don't register it)
 	isAutoApprove, _ := cmd.Flags().GetBool(string(flag.AutoApprove))
+	return runHcl(ctx, cmd, operation, provisioner.HclBinaryTofu, isAutoApprove)
+}
 
+// runHcl executes an HCL-based IaC operation (tofu or terraform) using the resolved context.
+func runHcl(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOperationType, binary provisioner.HclBinary,
+	isAutoApprove bool) error {
 	// Get reconfigure flag if defined (ignore error for commands that don't register it)
 	isReconfigure, _ := cmd.Flags().GetBool(string(flag.Reconfigure))
 
@@ -44,14 +43,18 @@ func runHcl(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOpera
 	// Check if binary is available before proceeding
 	if err := binary.CheckAvailable(); err != nil {
 		cliprint.PrintError(err.Error())
-		os.Exit(1)
+		return err
 	}
 
-	// Build and validate backend configuration before handoff
-	backendCfg, err := buildAndValidateBackendConfig(ctx, cmd, string(binary))
-	if err != nil {
-		cliprint.PrintError(err.Error())
-		os.Exit(1)
+	// Build and validate backend configuration before handoff (unless already resolved)
+	backendCfg := ctx.BackendConfig
+	if backendCfg == nil {
+		var err error
+		backendCfg, err = buildAndValidateBackendConfig(ctx, cmd, string(binary))
+		if err != nil {
+			cliprint.PrintError(err.Error())
+			return err
+		}
 	}
 
 	// ALWAYS display backend configuration before handoff
@@ -64,12 +67,22 @@ func runHcl(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOpera
 
 	cliprint.PrintHandoff(binary.DisplayName())
 
-	err = tofumodule.RunCommand(
+	// Outputs are read while the module is still initialized, so capturing them needs no second init
+	var outputs map[string]interface{}
+	var outputsErr error
+	var onOutputs func(map[string]interface{}, error)
+	if operation == terraform.TerraformOperationType_apply || operation == terraform.TerraformOperationType_refresh {
+		onOutputs = func(o map[string]interface{}, err error) {
+			outputs, outputsErr = o, err
+		}
+	}
+
+	err := tofumodule.RunCommandWithOutputs(
 		string(binary),
 		ctx.ModuleDir,
 		ctx.ManifestPath,
 		operation,
-		ctx.ValueOverrides,
+		nil,
 		isAutoApprove,
 		isDestroyPlan,
 		isReconfigure,
@@ -78,13 +91,23 @@ func runHcl(ctx *Context, cmd *cobra.Command, operation terraform.TerraformOpera
 		ctx.KubeContext,
 		ctx.ProviderConfig,
 		backendCfg,
+		onOutputs,
 	)
 	if err != nil {
 		printHclExecutionError(binary, err)
-		os.Exit(1)
+		return err
 	}
 
 	printHclSuccess(binary)
+
+	switch operation {
+	case terraform.TerraformOperationType_apply, terraform.TerraformOperationType_refresh:
+		// Reuse the resolved backend so recording the state location does not prompt again
+		ctx.BackendConfig = backendCfg
+		captureReadOutputs(ctx, cmd, outputs, outputsErr)
+	case terraform.TerraformOperationType_destroy:
+		forgetOutputs(ctx)
+	}
 	return nil
 }
 
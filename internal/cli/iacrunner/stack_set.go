@@ -0,0 +1,209 @@
+package iacrunner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/pulumi"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/internal/stackset"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	"github.com/spf13/cobra"
+)
+
+// StackSetOperation is the operation run against every manifest of a stack set.
+type StackSetOperation int
+
+const (
+	// StackSetApply applies manifests in dependency order.
+	StackSetApply StackSetOperation = iota
+	// StackSetDestroy destroys manifests in reverse dependency order.
+	StackSetDestroy
+)
+
+func (o StackSetOperation) verb() string {
+	if o == StackSetDestroy {
+		return "Destroying"
+	}
+	return "Applying"
+}
+
+// RunStackSet loads every manifest at manifestPath (a directory or multi-document YAML file),
+// orders them by their dependencies and runs the operation against each one, running
+// independent branches in parallel up to the --concurrency limit.
+//
+// Contexts are resolved for all manifests up front and sequentially, so any interactive
+// prompts (provisioner selection, missing backend configuration) happen before execution starts.
+// Unless --auto-approve (or --yes) is given, every Pulumi and Tofu/Terraform run asks for
+// approval on the terminal, so manifests then run one at a time.
+func RunStackSet(cmd *cobra.Command, manifestPath string, operation StackSetOperation) error {
+	cliprint.PrintStep(fmt.Sprintf("Loading stack set from %s...", manifestPath))
+	set, err := stackset.Load(manifestPath)
+	if err != nil {
+		manifest.HandleManifestLoadError(err)
+		return errors.Wrap(err, "failed to load stack set")
+	}
+	defer set.Cleanup()
+
+	if env, _ := cmd.Flags().GetString(string(flag.Env)); env != "" {
+		cliprint.PrintStep(fmt.Sprintf("Applying env overlay %s...", env))
+		if err := set.ApplyEnvOverlay(manifestPath, env); err != nil {
+			manifest.HandleManifestLoadError(err)
+			return errors.Wrap(err, "failed to apply env overlay")
+		}
+	}
+
+	graph, err := stackset.BuildGraph(set.Manifests)
+	if err != nil {
+		return errors.Wrap(err, "failed to build dependency graph")
+	}
+	cliprint.PrintSuccess(fmt.Sprintf("Loaded %d manifest(s)", len(graph.Nodes)))
+	printExecutionOrder(graph, operation)
+
+	contexts := map[*stackset.Node]*Context{}
+	defer func() {
+		for _, ctx := range contexts {
+			ctx.Cleanup()
+		}
+	}()
+
+	isAutoApprove := stackSetAutoApprove(cmd)
+	for _, node := range graph.Nodes {
+		cliprint.PrintInfo(fmt.Sprintf("Preparing %s (%s)", node.ID, node.Manifest.Source))
+		ctx, err := ResolveContextForManifest(cmd, node.Manifest.Path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to prepare %s", node.ID)
+		}
+		contexts[node] = ctx
+
+		if ctx.ProvisionerType == provisioner.ProvisionerTypeTofu || ctx.ProvisionerType == provisioner.ProvisionerTypeTerraform {
+			backendCfg, err := buildAndValidateBackendConfig(ctx, cmd, hclBinary(ctx.ProvisionerType).String())
+			if err != nil {
+				return errors.Wrapf(err, "failed to prepare %s", node.ID)
+			}
+			ctx.BackendConfig = backendCfg
+		}
+	}
+
+	concurrency, _ := cmd.Flags().GetInt(string(flag.Concurrency))
+	if !isAutoApprove && concurrency > 1 {
+		// Parallel runs would read their approval prompts from the same terminal
+		cliprint.PrintWarning(fmt.Sprintf("Running one manifest at a time: approval prompts need --%s to run in parallel",
+			flag.AutoApprove))
+		concurrency = 1
+	}
+
+	contextsByID := map[string]*Context{}
+	for node, ctx := range contexts {
+		contextsByID[node.ID] = ctx
+	}
+	source := newRefSource(cmd, contextsByID)
+
+	results := graph.Execute(stackset.ExecuteOptions{
+		Concurrency: concurrency,
+		Reverse:     operation == StackSetDestroy,
+	}, func(node *stackset.Node) error {
+		cliprint.PrintStep(fmt.Sprintf("%s %s", operation.verb(), node.ID))
+		if operation == StackSetDestroy {
+			resolveValueFromForDestroy(contexts[node], source)
+		} else if err := resolveValueFrom(contexts[node], source); err != nil {
+			// References point at nodes this one depends on, so their outputs exist by now
+			cliprint.PrintError(err.Error())
+			return err
+		}
+		return runStackSetNode(contexts[node], cmd, operation, isAutoApprove)
+	})
+
+	printStackSetSummary(results)
+
+	var failed []string
+	for _, r := range results {
+		if r.Status != stackset.StatusSucceeded {
+			failed = append(failed, r.Node.ID)
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("stack set did not complete: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// stackSetAutoApprove reports whether stack set runs skip approval prompts. Either the Tofu
+// --auto-approve or the Pulumi --yes flag approves the runs of both provisioners.
+func stackSetAutoApprove(cmd *cobra.Command) bool {
+	isAutoApprove, _ := cmd.Flags().GetBool(string(flag.AutoApprove))
+	yes, _ := cmd.Flags().GetBool(string(flag.Yes))
+	return isAutoApprove || yes
+}
+
+func runStackSetNode(ctx *Context, cmd *cobra.Command, operation StackSetOperation, isAutoApprove bool) error {
+	switch ctx.ProvisionerType {
+	case provisioner.ProvisionerTypePulumi:
+		pulumiOp := pulumi.PulumiOperationType_update
+		if operation == StackSetDestroy {
+			pulumiOp = pulumi.PulumiOperationType_destroy
+		}
+		return runPulumi(ctx, cmd, pulumiOp, false, isAutoApprove)
+	case provisioner.ProvisionerTypeTofu, provisioner.ProvisionerTypeTerraform:
+		tfOp := terraform.TerraformOperationType_apply
+		if operation == StackSetDestroy {
+			tfOp = terraform.TerraformOperationType_destroy
+		}
+		return runHcl(ctx, cmd, tfOp, hclBinary(ctx.ProvisionerType), isAutoApprove)
+	default:
+		return errors.New("unknown provisioner type")
+	}
+}
+
+func hclBinary(provisionerType provisioner.ProvisionerType) provisioner.HclBinary {
+	if provisionerType == provisioner.ProvisionerTypeTerraform {
+		return provisioner.HclBinaryTerraform
+	}
+	return provisioner.HclBinaryTofu
+}
+
+func printExecutionOrder(graph *stackset.Graph, operation StackSetOperation) {
+	nodes := graph.Nodes
+	if operation == StackSetDestroy {
+		nodes = make([]*stackset.Node, len(graph.Nodes))
+		for i, n := range graph.Nodes {
+			nodes[len(graph.Nodes)-1-i] = n
+		}
+	}
+	cliprint.PrintInfo("Execution order:")
+	for i, n := range nodes {
+		waitsOn := n.DependsOn
+		if operation == StackSetDestroy {
+			waitsOn = n.Dependents
+		}
+		line := fmt.Sprintf("  %d. %s", i+1, n.ID)
+		if len(waitsOn) > 0 {
+			var ids []string
+			for _, w := range waitsOn {
+				ids = append(ids, w.ID)
+			}
+			line += fmt.Sprintf(" (after %s)", strings.Join(ids, ", "))
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+}
+
+func printStackSetSummary(results []*stackset.NodeResult) {
+	fmt.Println()
+	cliprint.PrintInfo("Stack set summary:")
+	for _, r := range results {
+		switch r.Status {
+		case stackset.StatusSucceeded:
+			cliprint.PrintSuccess(r.Node.ID)
+		case stackset.StatusFailed:
+			cliprint.PrintError(fmt.Sprintf("%s: %v", r.Node.ID, r.Err))
+		case stackset.StatusSkipped:
+			cliprint.PrintWarning(fmt.Sprintf("%s: skipped (dependency did not complete)", r.Node.ID))
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package iacrunner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/cliprint"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/internal/stackoutputs"
+	"github.com/plantonhq/openmcf/internal/stackset"
+	"github.com/plantonhq/openmcf/internal/valuefrom"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisioner"
+	pulumibackendconfig "github.com/plantonhq/openmcf/pkg/iac/pulumi/backendconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/pulumi/pulumistack"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tofumodule"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// statusOutputsPrefix is the field path prefix of values read from a resource's stack outputs.
+const statusOutputsPrefix = "status.outputs."
+
+// refSource resolves value_from references against the resources of the current run.
+// Stack outputs are read from the referenced manifest's status.outputs when present and
+// otherwise fetched from its Pulumi stack or Tofu/Terraform state, once per resource.
+// Resources outside the run are read from the live state recorded in the local outputs cache
+// when their outputs were last captured.
+type refSource struct {
+	cmd   *cobra.Command
+	nodes map[string]*refNode
+
+	mu       sync.Mutex
+	external map[string]*externalRef
+}
+
+type refNode struct {
+	id      string
+	ctx     *Context
+	once    sync.Once
+	outputs map[string]interface{}
+	err     error
+}
+
+// externalRef is a referenced resource that is not part of the run.
+type externalRef struct {
+	once    sync.Once
+	outputs map[string]interface{}
+	// origin describes where the outputs were read from, for error messages
+	origin string
+	err    error
+}
+
+// newRefSource builds a source over the given contexts, keyed by stack set node ID.
+func newRefSource(cmd *cobra.Command, contexts map[string]*Context) *refSource {
+	source := &refSource{cmd: cmd, nodes: map[string]*refNode{}, external: map[string]*externalRef{}}
+	for id, ctx := range contexts {
+		source.nodes[id] = &refNode{id: id, ctx: ctx}
+	}
+	return source
+}
+
+// Lookup implements valuefrom.Source.
+func (s *refSource) Lookup(target valuefrom.Target, fieldPath string) (interface{}, error) {
+	id := stackset.NodeID(crkreflect.ExtractKindNameByKind(target.Kind), target.Env, target.Name)
+	node, ok := s.nodes[id]
+	if !ok {
+		return s.lookupExternal(id, fieldPath)
+	}
+
+	manifestData, err := protoToMap(node.ctx.ManifestObject)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest of %s", id)
+	}
+	if value, found := valuefrom.ValueAtPath(manifestData, fieldPath); found {
+		return value, nil
+	}
+	if !strings.HasPrefix(fieldPath, statusOutputsPrefix) {
+		return nil, errors.Errorf("%s has no value at %s", id, fieldPath)
+	}
+
+	outputs, err := node.stackOutputs(s.cmd)
+	if err != nil {
+		return nil, err
+	}
+	outputName := strings.TrimPrefix(fieldPath, statusOutputsPrefix)
+	value, found := valuefrom.ValueAtPath(outputs, outputName)
+	if !found {
+		return nil, errors.Errorf("%s has no stack output %s", id, outputName)
+	}
+	return value, nil
+}
+
+// lookupExternal resolves a reference to a resource outside the run from its stack outputs,
+// reading them once per resource.
+func (s *refSource) lookupExternal(id, fieldPath string) (interface{}, error) {
+	if !strings.HasPrefix(fieldPath, statusOutputsPrefix) {
+		return nil, errors.Errorf("%s is not part of this run, so only its status.outputs can be referenced; "+
+			"pass a directory or multi-document file containing both manifests to --%s", id, flag.Manifest)
+	}
+
+	s.mu.Lock()
+	ref, ok := s.external[id]
+	if !ok {
+		ref = &externalRef{}
+		s.external[id] = ref
+	}
+	s.mu.Unlock()
+
+	ref.once.Do(func() {
+		ref.outputs, ref.origin, ref.err = readExternalOutputs(id)
+	})
+	if ref.err != nil {
+		return nil, ref.err
+	}
+	outputName := strings.TrimPrefix(fieldPath, statusOutputsPrefix)
+	value, found := valuefrom.ValueAtPath(ref.outputs, outputName)
+	if !found {
+		return nil, errors.Errorf("%s has no stack output %s in %s", id, outputName, ref.origin)
+	}
+	return value, nil
+}
+
+// readExternalOutputs reads the current outputs of a resource outside the run from its Pulumi
+// stack or Tofu/Terraform backend. The outputs cache records where that state lives whenever
+// outputs are captured; the cached outputs are only used when the state cannot be read.
+func readExternalOutputs(id string) (map[string]interface{}, string, error) {
+	entry, err := stackoutputs.Load(id)
+	if err != nil {
+		return nil, "", err
+	}
+	if entry == nil {
+		return nil, "", errors.Errorf("%s is not part of this run and the location of its state is unknown; apply or "+
+			"refresh it, or run `openmcf outputs` for it, or pass a directory or multi-document file containing both "+
+			"manifests to --%s", id, flag.Manifest)
+	}
+
+	capturedAt := fmt.Sprintf("outputs captured at %s", entry.CapturedAt.Format(time.RFC3339))
+	if entry.State == nil {
+		cliprint.PrintWarning(fmt.Sprintf("The state of %s can only be read through its manifest, using %s", id, capturedAt))
+		return entry.Outputs, capturedAt, nil
+	}
+
+	cliprint.PrintStep(fmt.Sprintf("Reading stack outputs of %s...", id))
+	outputs, err := readStateOutputs(entry.State)
+	if err != nil {
+		cliprint.PrintWarning(fmt.Sprintf("Could not read the state of %s, using %s: %s", id, capturedAt, err))
+		return entry.Outputs, capturedAt, nil
+	}
+	if err := stackoutputs.Save(id, outputs, entry.State); err != nil {
+		cliprint.PrintWarning(fmt.Sprintf("Stack outputs of %s were not cached: %s", id, err))
+	}
+	return outputs, "its current state", nil
+}
+
+func (n *refNode) stackOutputs(cmd *cobra.Command) (map[string]interface{}, error) {
+	n.once.Do(func() {
+		cliprint.PrintStep(fmt.Sprintf("Reading stack outputs of %s...", n.id))
+		n.outputs, n.err = fetchStackOutputs(n.ctx, cmd)
+		if n.err != nil {
+			n.err = errors.Wrapf(n.err, "failed to read stack outputs of %s", n.id)
+		}
+	})
+	return n.outputs, n.err
+}
+
+// fetchStackOutputs reads the outputs of the resource described by ctx from its provisioner's state.
+func fetchStackOutputs(ctx *Context, cmd *cobra.Command) (map[string]interface{}, error) {
+	switch ctx.ProvisionerType {
+	case provisioner.ProvisionerTypePulumi:
+		return pulumistack.GetOutputs(pulumiStackFqdn(ctx, cmd))
+	case provisioner.ProvisionerTypeTofu, provisioner.ProvisionerTypeTerraform:
+		backendCfg, err := hclBackendConfig(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return tofumodule.GetOutputs(
+			hclBinary(ctx.ProvisionerType).String(),
+			ctx.ModuleDir,
+			ctx.ManifestPath,
+			nil,
+			ctx.ModuleVersion,
+			ctx.NoCleanup,
+			ctx.KubeContext,
+			ctx.ProviderConfig,
+			backendCfg,
+		)
+	default:
+		return nil, errors.New("unknown provisioner type")
+	}
+}
+
+// pulumiStackFqdn returns the Pulumi stack of the context's resource: the manifest's stack
+// labels take priority over the --stack flag.
+func pulumiStackFqdn(ctx *Context, cmd *cobra.Command) string {
+	stackFqdn, _ := cmd.Flags().GetString(string(flag.Stack))
+	if cfg, err := pulumibackendconfig.ExtractFromManifest(ctx.ManifestObject); err == nil && cfg != nil && cfg.StackFqdn != "" {
+		stackFqdn = cfg.StackFqdn
+	}
+	return stackFqdn
+}
+
+// hclBackendConfig returns the context's Tofu/Terraform backend, resolving it once from flags
+// and manifest labels so later calls do not prompt again.
+func hclBackendConfig(ctx *Context, cmd *cobra.Command) (*backendconfig.TofuBackendConfig, error) {
+	if ctx.BackendConfig == nil {
+		backendCfg, err := buildAndValidateBackendConfig(ctx, cmd, hclBinary(ctx.ProvisionerType).String())
+		if err != nil {
+			return nil, err
+		}
+		ctx.BackendConfig = backendCfg
+	}
+	return ctx.BackendConfig, nil
+}
+
+// ResolveReferences substitutes the value_from references of a single manifest with the stack
+// outputs of the resources they point at, which are read from their current state. Commands
+// that hand the manifest's values to the provisioner call it after ResolveContext.
+func ResolveReferences(ctx *Context, cmd *cobra.Command) error {
+	return resolveValueFrom(ctx, newRefSource(cmd, nil))
+}
+
+// ResolveReferencesForDestroy is like ResolveReferences, but a destroy does not depend on the
+// referenced values, so unresolvable references are reported and left unset.
+func ResolveReferencesForDestroy(ctx *Context, cmd *cobra.Command) {
+	resolveValueFromForDestroy(ctx, newRefSource(cmd, nil))
+}
+
+func resolveValueFromForDestroy(ctx *Context, source valuefrom.Source) {
+	if err := resolveValueFrom(ctx, source); err != nil {
+		cliprint.PrintWarning(fmt.Sprintf("Destroying with unresolved value_from references: %s", err))
+	}
+}
+
+// resolveValueFrom substitutes every value_from reference in the context's manifest with the
+// value looked up from source. The resolved manifest is written to a temp file which replaces
+// ctx.ManifestPath, so the stack input handed to the provisioner only contains literals.
+func resolveValueFrom(ctx *Context, source valuefrom.Source) error {
+	refs := valuefrom.FindRefs(ctx.ManifestObject)
+	if len(refs) == 0 {
+		return nil
+	}
+	cliprint.PrintStep(fmt.Sprintf("Resolving %d value_from reference(s)...", len(refs)))
+
+	env := ""
+	if metadata := metadatareflect.ExtractMetadata(ctx.ManifestObject); metadata != nil {
+		env = metadata.Env
+	}
+
+	resolved := proto.Clone(ctx.ManifestObject)
+	if err := valuefrom.Resolve(resolved, env, source); err != nil {
+		return err
+	}
+
+	resolvedPath, err := manifest.WriteToTempFile(resolved, "manifest-resolved-*.yaml")
+	if err != nil {
+		return errors.Wrap(err, "failed to write resolved manifest")
+	}
+	ctx.AddCleanupFunc(func() { os.Remove(resolvedPath) })
+	ctx.ManifestPath = resolvedPath
+	ctx.ManifestObject = resolved
+
+	cliprint.PrintSuccess("References resolved")
+	return nil
+}
+
+// protoToMap converts a manifest to decoded JSON keyed by proto field names,
+// matching the snake_case field paths used by value_from references.
+func protoToMap(msg proto.Message) (map[string]interface{}, error) {
+	jsonBytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(jsonBytes, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
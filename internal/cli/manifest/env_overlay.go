@@ -0,0 +1,33 @@
+package manifest
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest/envoverlay"
+)
+
+// applyEnvOverlay applies the overlay of env to the resolved manifest. Overlays are looked up
+// next to the manifest, so it must be a local file given with --manifest or --input-dir.
+func applyEnvOverlay(manifestPath string, isTemp bool, env string) (string, bool, error) {
+	if isTemp || strings.Contains(manifestPath, "://") {
+		if isTemp {
+			os.Remove(manifestPath)
+		}
+		return "", false, errors.New("--env overlays require a local manifest given with --manifest or --input-dir")
+	}
+	overlay, err := envoverlay.Load(manifestPath, env)
+	if err != nil {
+		return "", false, err
+	}
+	patchedPath, err := overlay.ApplyToFile(manifestPath)
+	if err != nil {
+		return "", false, err
+	}
+	if err := overlay.CheckMatched(); err != nil {
+		os.Remove(patchedPath)
+		return "", false, err
+	}
+	return patchedPath, true, nil
+}
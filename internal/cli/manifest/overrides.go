@@ -0,0 +1,22 @@
+package manifest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+// GetOverrides returns the --set overrides in command-line order. Repeated paths are kept,
+// so --set spec.zones[+]=a --set spec.zones[+]=b appends both values.
+func GetOverrides(cmd *cobra.Command) ([]manifest.Override, error) {
+	pairs, err := flag.GetSetPairs(cmd.Flags())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get set flag")
+	}
+	overrides := make([]manifest.Override, 0, len(pairs))
+	for _, pair := range pairs {
+		overrides = append(overrides, manifest.Override{Path: pair.Key, Value: pair.Value})
+	}
+	return overrides, nil
+}
@@ -0,0 +1,46 @@
+package manifest
+
+import (
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/flag"
+	"github.com/plantonhq/openmcf/internal/cli/iacflags"
+	"github.com/plantonhq/openmcf/internal/stackset"
+	"github.com/spf13/cobra"
+)
+
+// ResolveStackSetPath checks whether the --manifest flag points at a stack set, i.e. a directory
+// or a multi-document YAML file. It returns the path and true when it does.
+func ResolveStackSetPath(cmd *cobra.Command) (string, bool, error) {
+	// Clipboard input takes priority over --manifest and is always a single manifest
+	if useClipboard, _ := iacflags.IsClipboardFlagSet(cmd); useClipboard {
+		return "", false, nil
+	}
+
+	manifestPath, err := cmd.Flags().GetString(string(flag.Manifest))
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to get manifest flag")
+	}
+	if manifestPath == "" {
+		return "", false, nil
+	}
+
+	isStackSet, err := stackset.IsStackSet(manifestPath)
+	if err != nil {
+		return "", false, err
+	}
+	if !isStackSet {
+		return "", false, nil
+	}
+
+	// A stack set is always loaded from --manifest; other manifest sources and
+	// single-manifest settings cannot be combined with it.
+	for _, f := range []flag.Flag{flag.StackInput, flag.InputDir, flag.KustomizeDir, flag.Stack} {
+		if value, _ := cmd.Flags().GetString(string(f)); value != "" {
+			return "", false, errors.Errorf("--%s cannot be used when --manifest is a directory or multi-document file", f)
+		}
+	}
+	if overrides, _ := cmd.Flags().GetStringToString(string(flag.Set)); len(overrides) > 0 {
+		return "", false, errors.Errorf("--%s cannot be used when --manifest is a directory or multi-document file", flag.Set)
+	}
+	return manifestPath, true, nil
+}
@@ -16,11 +16,26 @@ import (
 //  5. --kustomize-dir + --overlay flags (if both provided, build kustomize manifest)
 //  6. Error if none of the above are provided
 //
+// With --env, the env/<env>.yaml patches next to the --manifest or --input-dir manifest are
+// applied and the patched manifest is written to a temporary file.
+//
 // Returns:
 //   - manifestPath: The resolved path to the manifest file
 //   - isTemp: Whether the manifest file is temporary and should be cleaned up
 //   - error: Any error encountered during resolution
 func ResolveManifestPath(cmd *cobra.Command) (string, bool, error) {
+	manifestPath, isTemp, err := resolveManifestSource(cmd)
+	if err != nil {
+		return "", false, err
+	}
+	env, _ := cmd.Flags().GetString(string(flag.Env))
+	if env == "" {
+		return manifestPath, isTemp, nil
+	}
+	return applyEnvOverlay(manifestPath, isTemp, env)
+}
+
+func resolveManifestSource(cmd *cobra.Command) (string, bool, error) {
 	// Priority 1: Check for --clipboard flag (reads manifest from system clipboard)
 	clipboardManifest, isTemp, err := resolveFromClipboard(cmd)
 	if err != nil {
@@ -0,0 +1,38 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/plantonhq/openmcf/internal/manifest/scaffold"
+)
+
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// PromptForManifestField asks for the value of a required manifest field.
+// Prompts are written to stderr so the generated manifest can be redirected from stdout.
+// An empty answer keeps the field's placeholder.
+func PromptForManifestField(field *scaffold.PromptField) (string, error) {
+	cyan := color.New(color.FgCyan).SprintFunc()
+	yellow := color.New(color.FgYellow).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	if field.Error != nil {
+		fmt.Fprintf(os.Stderr, "   %s\n", red(field.Error.Error()))
+	}
+	fmt.Fprintf(os.Stderr, "%s %s\n", cyan(field.Path), yellow(field.Hint))
+	if field.Placeholder != "" && field.Placeholder != `""` {
+		fmt.Fprintf(os.Stderr, "Enter value [%s]: ", field.Placeholder)
+	} else {
+		fmt.Fprint(os.Stderr, "Enter value: ")
+	}
+
+	input, err := stdinReader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read input for %s: %w", field.Path, err)
+	}
+	return strings.TrimSpace(input), nil
+}
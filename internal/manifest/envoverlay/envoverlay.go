@@ -0,0 +1,239 @@
+// Package envoverlay applies per-environment patches to manifests without a kustomize layout.
+//
+// The patches of environment <name> live in env/<name>.yaml next to the manifest, or inside
+// the directory of a stack set. Each YAML document of the file is a patch keyed by field
+// path, using the same paths as --set:
+//
+//	kind: AwsVpc          # optional selectors: kind and metadata.name
+//	metadata.name: main
+//	spec.subnetSize: 512
+//	spec:
+//	  isNatGatewayEnabled: true
+//	metadata.labels.team: network
+//
+// Nested mappings are merged field by field, lists and scalars replace the base value, and
+// null clears a field. Patches without selectors apply to every manifest. After the patches,
+// metadata.env is set to the environment name.
+package envoverlay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"gopkg.in/yaml.v3"
+)
+
+// DirName is the directory next to a manifest holding its environment overlays.
+const DirName = "env"
+
+const (
+	kindPath = "kind"
+	namePath = "metadata.name"
+	envPath  = "metadata.env"
+)
+
+// Overlay is the set of patches of one environment.
+type Overlay struct {
+	Env string
+	// Path is the overlay file. It does not have to exist; a missing file only sets metadata.env.
+	Path    string
+	Patches []*Patch
+}
+
+// Patch is one document of an overlay file.
+type Patch struct {
+	// Source is the file and document the patch was read from, e.g. env/prod.yaml#2
+	Source string
+	// Kind and Name select the manifests the patch applies to; empty selects all
+	Kind string
+	Name string
+	// Overrides are the field paths and values of the patch, in file order
+	Overrides []manifest.Override
+
+	matched bool
+}
+
+// PathFor returns the overlay file of env for the manifest file or stack set directory at path.
+func PathFor(path, env string) (string, error) {
+	if env == "" || env == "." || env == ".." || strings.ContainsAny(env, `/\`) {
+		return "", errors.Errorf("invalid env %q", env)
+	}
+	dir := filepath.Dir(path)
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		dir = path
+	}
+	return filepath.Join(dir, DirName, env+".yaml"), nil
+}
+
+// Load reads the overlay of env for the manifest file or stack set directory at path.
+func Load(path, env string) (*Overlay, error) {
+	overlayPath, err := PathFor(path, env)
+	if err != nil {
+		return nil, err
+	}
+	overlay := &Overlay{Env: env, Path: overlayPath}
+	content, err := os.ReadFile(overlayPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return overlay, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read %s", overlayPath)
+	}
+	overlay.Patches, err = Parse(content, overlayPath)
+	if err != nil {
+		return nil, err
+	}
+	return overlay, nil
+}
+
+// Parse reads the patches of an overlay file; source names the file in errors.
+func Parse(content []byte, source string) ([]*Patch, error) {
+	var patches []*Patch
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for i := 1; ; i++ {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrapf(err, "failed to parse %s", source)
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Tag == "!!null" {
+			continue
+		}
+		patch := &Patch{Source: fmt.Sprintf("%s#%d", source, i)}
+		if doc.Content[0].Kind != yaml.MappingNode {
+			return nil, errors.Errorf("%s: a patch must be a mapping of field paths to values", patch.Source)
+		}
+		overrides, err := flatten(doc.Content[0], "")
+		if err != nil {
+			return nil, errors.Wrap(err, patch.Source)
+		}
+		for _, o := range overrides {
+			switch o.Path {
+			case kindPath:
+				patch.Kind = o.Value
+			case namePath:
+				patch.Name = o.Value
+			case envPath:
+				return nil, errors.Errorf("%s: %s is set from the overlay name and cannot be patched", patch.Source, envPath)
+			default:
+				patch.Overrides = append(patch.Overrides, o)
+			}
+		}
+		patches = append(patches, patch)
+	}
+	return patches, nil
+}
+
+// flatten turns a YAML value into overrides. Mappings are descended into so that they merge
+// into the manifest field by field; any other value becomes a single override.
+func flatten(node *yaml.Node, prefix string) ([]manifest.Override, error) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			return []manifest.Override{{Path: prefix, Value: "{}"}}, nil
+		}
+		var overrides []manifest.Override
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if key == "" {
+				return nil, errors.Errorf("line %d: empty field path", node.Content[i].Line)
+			}
+			nested, err := flatten(node.Content[i+1], joinPath(prefix, key))
+			if err != nil {
+				return nil, err
+			}
+			overrides = append(overrides, nested...)
+		}
+		return overrides, nil
+	case yaml.SequenceNode:
+		var items interface{}
+		if err := node.Decode(&items); err != nil {
+			return nil, errors.Wrapf(err, "line %d", node.Line)
+		}
+		literal, err := json.Marshal(items)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", node.Line)
+		}
+		return []manifest.Override{{Path: prefix, Value: string(literal)}}, nil
+	default:
+		if node.Tag == "!!null" {
+			return []manifest.Override{{Path: prefix, Value: "null"}}, nil
+		}
+		return []manifest.Override{{Path: prefix, Value: node.Value}}, nil
+	}
+}
+
+func joinPath(prefix, key string) string {
+	switch {
+	case prefix == "":
+		return key
+	case strings.HasPrefix(key, "["):
+		return prefix + key
+	default:
+		return prefix + "." + key
+	}
+}
+
+// Overrides returns the overrides that apply the overlay to the manifest of the given kind
+// and name: those of every patch selecting it, followed by setting metadata.env.
+func (o *Overlay) Overrides(kindName, name string) []manifest.Override {
+	var overrides []manifest.Override
+	for _, p := range o.Patches {
+		if (p.Kind != "" && p.Kind != kindName) || (p.Name != "" && p.Name != name) {
+			continue
+		}
+		p.matched = true
+		overrides = append(overrides, p.Overrides...)
+	}
+	return append(overrides, manifest.Override{Path: envPath, Value: o.Env})
+}
+
+// ApplyToFile writes the manifest at manifestPath with the overlay applied to a new temp file
+// and returns its path. The caller is responsible for removing the file.
+func (o *Overlay) ApplyToFile(manifestPath string) (string, error) {
+	base, err := manifest.LoadManifest(manifestPath)
+	if err != nil {
+		return "", err
+	}
+	kindName, err := crkreflect.ExtractKindFromProto(base)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to extract kind")
+	}
+	name := metadatareflect.ExtractMetadata(base).GetName()
+
+	// The overrides always include metadata.env, so a temp file is always written
+	patched, _, err := manifest.ApplyOverridesToFile(manifestPath, o.Overrides(kindName, name))
+	if err != nil {
+		return "", err
+	}
+	return patched, nil
+}
+
+// CheckMatched returns an error naming the patches that selected none of the manifests they
+// were applied to, which usually means a misspelled kind or name.
+func (o *Overlay) CheckMatched() error {
+	var unmatched []string
+	for _, p := range o.Patches {
+		if !p.matched {
+			unmatched = append(unmatched, fmt.Sprintf("%s (kind %q, name %q)", p.Source, p.Kind, p.Name))
+		}
+	}
+	if len(unmatched) > 0 {
+		return errors.Errorf("env overlay patches match no manifest: %s", strings.Join(unmatched, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package envoverlay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testVpcManifest = `apiVersion: aws.openmcf.org/v1
+kind: AwsVpc
+metadata:
+  name: main
+  labels:
+    team: network
+spec:
+  vpcCidr: 10.0.0.0/16
+  availabilityZones: [us-east-1a]
+  subnetsPerAvailabilityZone: 1
+  subnetSize: 256
+`
+
+func TestParse(t *testing.T) {
+	patches, err := Parse([]byte(`
+spec.subnetSize: 512
+spec:
+  isNatGatewayEnabled: true
+  availabilityZones: [us-east-1a, us-east-1b]
+metadata:
+  labels:
+    app.kubernetes.io/name: vpc
+---
+kind: AwsVpc
+metadata.name: main
+spec.vpcCidr: null
+`), "env/prod.yaml")
+	require.NoError(t, err)
+	require.Len(t, patches, 2)
+
+	assert.Equal(t, []manifest.Override{
+		{Path: "spec.subnetSize", Value: "512"},
+		{Path: "spec.isNatGatewayEnabled", Value: "true"},
+		{Path: "spec.availabilityZones", Value: `["us-east-1a","us-east-1b"]`},
+		{Path: "metadata.labels.app.kubernetes.io/name", Value: "vpc"},
+	}, patches[0].Overrides)
+	assert.Empty(t, patches[0].Kind)
+
+	assert.Equal(t, "env/prod.yaml#2", patches[1].Source)
+	assert.Equal(t, "AwsVpc", patches[1].Kind)
+	assert.Equal(t, "main", patches[1].Name)
+	assert.Equal(t, []manifest.Override{{Path: "spec.vpcCidr", Value: "null"}}, patches[1].Overrides)
+}
+
+func TestParse_Errors(t *testing.T) {
+	for name, content := range map[string]string{
+		"not a mapping": "- spec.subnetSize: 512\n",
+		"env patched":   "metadata.env: dev\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(content), "env/prod.yaml")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestApplyToFile(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "vpc.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(testVpcManifest), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, DirName), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, DirName, "prod.yaml"), []byte(`
+spec:
+  subnetSize: 512
+  availabilityZones: [us-east-1a, us-east-1b]
+metadata.labels.tier: core
+---
+kind: AwsVpc
+metadata.name: other
+spec.isNatGatewayEnabled: true
+`), 0o600))
+
+	overlay, err := Load(manifestPath, "prod")
+	require.NoError(t, err)
+	patchedPath, err := overlay.ApplyToFile(manifestPath)
+	require.NoError(t, err)
+	defer os.Remove(patchedPath)
+
+	patched, err := manifest.LoadManifest(patchedPath)
+	require.NoError(t, err)
+	vpc := patched.(*awsvpcv1.AwsVpc)
+	assert.Equal(t, "prod", vpc.Metadata.Env)
+	assert.Equal(t, map[string]string{"team": "network", "tier": "core"}, vpc.Metadata.Labels)
+	assert.Equal(t, int32(512), vpc.Spec.SubnetSize)
+	assert.Equal(t, []string{"us-east-1a", "us-east-1b"}, vpc.Spec.AvailabilityZones)
+	assert.Equal(t, "10.0.0.0/16", vpc.Spec.VpcCidr)
+	assert.False(t, vpc.Spec.IsNatGatewayEnabled)
+
+	assert.ErrorContains(t, overlay.CheckMatched(), "env/prod.yaml#2")
+}
+
+func TestLoad_MissingOverlayOnlySetsEnv(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "vpc.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(testVpcManifest), 0o600))
+
+	overlay, err := Load(manifestPath, "dev")
+	require.NoError(t, err)
+	assert.Empty(t, overlay.Patches)
+	assert.Equal(t, []manifest.Override{{Path: "metadata.env", Value: "dev"}}, overlay.Overrides("AwsVpc", "main"))
+}
+
+func TestPathFor(t *testing.T) {
+	dir := t.TempDir()
+	path, err := PathFor(filepath.Join(dir, "vpc.yaml"), "prod")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "env", "prod.yaml"), path)
+
+	path, err = PathFor(dir, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "env", "prod.yaml"), path)
+
+	_, err = PathFor(dir, "../prod")
+	assert.Error(t, err)
+}
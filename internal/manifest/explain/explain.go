@@ -0,0 +1,250 @@
+// Package explain documents the fields of cloud resource kinds, in the style of `kubectl explain`,
+// from their proto descriptors and the API docs embedded in the binary.
+package explain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"github.com/plantonhq/openmcf/internal/apidocs"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	gendoc "github.com/pseudomuto/protoc-gen-doc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Explain describes the kind or field named by target, e.g. "AwsVpc" or "AwsVpc.spec.subnets".
+// Field names may be given in their manifest (camelCase) or proto (snake_case) form.
+// With recursive, the fields of nested messages are listed as a tree instead of documented.
+func Explain(target string, recursive bool) (string, error) {
+	kindName, fieldPath, _ := strings.Cut(target, ".")
+	kind := crkreflect.KindFromString(kindName)
+	if kind == cloudresourcekind.CloudResourceKind_unspecified || crkreflect.ToMessageMap[kind] == nil {
+		return "", errors.Errorf("unknown cloud resource kind: %s", kindName)
+	}
+	msg, err := crkreflect.NewInstance(kind)
+	if err != nil {
+		return "", err
+	}
+	docs, err := apidocs.GetApiDocsJson()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load api docs")
+	}
+
+	md := msg.ProtoReflect().Descriptor()
+	var field protoreflect.FieldDescriptor
+	var parent protoreflect.MessageDescriptor
+	var path []string
+	if fieldPath != "" {
+		for _, name := range strings.Split(fieldPath, ".") {
+			if md == nil {
+				return "", errors.Errorf("%s is a %s and has no fields", strings.Join(path, "."), typeName(field))
+			}
+			parent, field = md, findField(md, name)
+			if field == nil {
+				return "", errors.Errorf("field %q does not exist in %s, expected one of: %s",
+					name, md.Name(), strings.Join(fieldNames(md), ", "))
+			}
+			path = append(path, field.JSONName())
+			md = elementMessage(field)
+		}
+	}
+
+	w := &writer{docs: docs}
+	w.line(0, "KIND:     "+crkreflect.ExtractKindNameByKind(kind))
+	w.line(0, "VERSION:  "+crkreflect.GroupVersion(kind))
+	w.blank()
+
+	var description string
+	if field == nil {
+		description = apidocs.MessageDescription(docs, string(md.FullName()))
+	} else {
+		w.line(0, fmt.Sprintf("FIELD:    %s <%s>", strings.Join(path, "."), typeName(field)))
+		w.blank()
+		description = apidocs.FieldDescription(docs, string(parent.FullName()), string(field.Name()))
+		if md != nil {
+			// Field comments on message fields tend to be short, so the message's own comment follows
+			if messageDescription := apidocs.MessageDescription(docs, string(md.FullName())); messageDescription != "" {
+				description = strings.TrimSpace(description + "\n\n" + messageDescription)
+			}
+		}
+	}
+	w.section("DESCRIPTION", splitLines(description))
+
+	if field != nil {
+		info := newFieldInfo(field)
+		w.section("DEFAULT", info.defaults())
+		w.section("VALIDATION", info.validation())
+		if enum := elementEnum(field); enum != nil {
+			w.section("ALLOWED VALUES", w.enumValues(enum))
+		}
+	}
+	if md != nil {
+		w.section("MESSAGE VALIDATION", messageValidation(md))
+		if md.Fields().Len() > 0 {
+			w.line(0, "FIELDS:")
+			if recursive {
+				w.tree(md, 1, nil)
+			} else {
+				w.fields(md)
+			}
+		}
+	}
+	return strings.TrimRight(w.String(), "\n") + "\n", nil
+}
+
+// findField returns the field of md with the given manifest or proto name.
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := md.Fields().ByJSONName(name); field != nil {
+		return field
+	}
+	return md.Fields().ByName(protoreflect.Name(name))
+}
+
+func fieldNames(md protoreflect.MessageDescriptor) []string {
+	var names []string
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		names = append(names, fields.Get(i).JSONName())
+	}
+	return names
+}
+
+// elementMessage returns the message a field holds, looking through lists and maps,
+// or nil for scalar and enum fields.
+func elementMessage(field protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if field.IsMap() {
+		return field.MapValue().Message()
+	}
+	return field.Message()
+}
+
+func elementEnum(field protoreflect.FieldDescriptor) protoreflect.EnumDescriptor {
+	if field.IsMap() {
+		return field.MapValue().Enum()
+	}
+	return field.Enum()
+}
+
+// typeName formats the type of a field the way it is written in a manifest, e.g. []string.
+func typeName(field protoreflect.FieldDescriptor) string {
+	switch {
+	case field.IsMap():
+		return fmt.Sprintf("map[%s]%s", typeName(field.MapKey()), typeName(field.MapValue()))
+	case field.IsList():
+		return "[]" + elementTypeName(field)
+	default:
+		return elementTypeName(field)
+	}
+}
+
+func elementTypeName(field protoreflect.FieldDescriptor) string {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(field.Message().Name())
+	case protoreflect.EnumKind:
+		return string(field.Enum().Name())
+	default:
+		return field.Kind().String()
+	}
+}
+
+type writer struct {
+	docs *gendoc.Template
+	strings.Builder
+}
+
+func (w *writer) line(indent int, text string) {
+	if text == "" {
+		w.blank()
+		return
+	}
+	w.WriteString(strings.Repeat("  ", indent) + text + "\n")
+}
+
+func (w *writer) blank() {
+	w.WriteString("\n")
+}
+
+// section writes a titled block of lines, or nothing when there are no lines.
+func (w *writer) section(title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	w.line(0, title+":")
+	for _, l := range lines {
+		w.line(2, l)
+	}
+	w.blank()
+}
+
+// fields documents each field of md with its type, markers and description.
+func (w *writer) fields(md protoreflect.MessageDescriptor) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		info := newFieldInfo(field)
+		w.line(1, fmt.Sprintf("%s\t<%s>%s", field.JSONName(), typeName(field), info.markers()))
+		description := apidocs.FieldDescription(w.docs, string(md.FullName()), string(field.Name()))
+		for _, l := range splitLines(description) {
+			w.line(3, l)
+		}
+		w.blank()
+	}
+}
+
+// tree lists the fields of md and of every nested message. Messages already being listed
+// higher up are not expanded again, so recursive types terminate.
+func (w *writer) tree(md protoreflect.MessageDescriptor, indent int, stack []protoreflect.FullName) {
+	stack = append(stack, md.FullName())
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		w.line(indent, fmt.Sprintf("%s\t<%s>%s", field.JSONName(), typeName(field), newFieldInfo(field).markers()))
+		nested := elementMessage(field)
+		if nested == nil || isOnStack(stack, nested.FullName()) {
+			continue
+		}
+		w.tree(nested, indent+1, stack)
+	}
+}
+
+func (w *writer) enumValues(enum protoreflect.EnumDescriptor) []string {
+	var lines []string
+	values := enum.Values()
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		if value.Number() == 0 {
+			// The zero value means unset and is rejected wherever a value is required
+			continue
+		}
+		line := string(value.Name())
+		if description := apidocs.EnumValueDescription(w.docs, string(enum.FullName()), string(value.Name())); description != "" {
+			line += " - " + strings.Join(splitLines(description), " ")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func isOnStack(stack []protoreflect.FullName, name protoreflect.FullName) bool {
+	for _, seen := range stack {
+		if seen == name {
+			return true
+		}
+	}
+	return false
+}
+
+func splitLines(text string) []string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+	return lines
+}
@@ -0,0 +1,53 @@
+package explain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain_Kind(t *testing.T) {
+	out, err := Explain("AwsVpc", false)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "KIND:     AwsVpc\n")
+	assert.Contains(t, out, "VERSION:  aws.openmcf.org/v1\n")
+	assert.Contains(t, out, "spec\t<AwsVpcSpec> -required-")
+}
+
+func TestExplain_Field(t *testing.T) {
+	out, err := Explain("AwsVpc.spec.subnetsPerAvailabilityZone", false)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "FIELD:    spec.subnetsPerAvailabilityZone <int32>")
+	assert.Contains(t, out, "The number of subnets to be created in each availability zone.")
+	assert.Contains(t, out, "recommended: 1")
+	assert.NotContains(t, out, "FIELDS:")
+}
+
+func TestExplain_AcceptsProtoFieldNames(t *testing.T) {
+	out, err := Explain("AwsVpc.spec.vpc_cidr", false)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "FIELD:    spec.vpcCidr <string>")
+	assert.Contains(t, out, "VALIDATION:\n    required\n")
+}
+
+func TestExplain_Recursive(t *testing.T) {
+	out, err := Explain("GcpGkeCluster.spec", true)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "  projectId\t<StringValueOrRef> -required-\n    value\t<string>\n")
+}
+
+func TestExplain_Errors(t *testing.T) {
+	_, err := Explain("NotAKind", false)
+	assert.ErrorContains(t, err, "unknown cloud resource kind")
+
+	_, err = Explain("AwsVpc.spec.vpcCider", false)
+	assert.ErrorContains(t, err, `field "vpcCider" does not exist in AwsVpcSpec`)
+
+	_, err = Explain("AwsVpc.spec.vpcCidr.value", false)
+	assert.ErrorContains(t, err, "spec.vpcCidr is a string and has no fields")
+}
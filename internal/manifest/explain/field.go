@@ -0,0 +1,110 @@
+package explain
+
+import (
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/plantonhq/openmcf/internal/manifest/fieldoptions"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldInfo describes a field's validation rules and default options.
+type fieldInfo struct {
+	*fieldoptions.Options
+}
+
+func newFieldInfo(field protoreflect.FieldDescriptor) *fieldInfo {
+	return &fieldInfo{fieldoptions.Of(field)}
+}
+
+// markers returns the short annotations written after a field's type in field listings.
+func (i *fieldInfo) markers() string {
+	var markers string
+	if i.Required() {
+		markers += " -required-"
+	}
+	if i.Default != "" {
+		markers += fmt.Sprintf(" (default: %s)", i.Default)
+	}
+	if i.RecommendedDefault != "" {
+		markers += fmt.Sprintf(" (recommended: %s)", i.RecommendedDefault)
+	}
+	return markers
+}
+
+func (i *fieldInfo) defaults() []string {
+	var lines []string
+	if i.Default != "" {
+		lines = append(lines, "default: "+i.Default)
+	}
+	if i.RecommendedDefault != "" {
+		lines = append(lines, "recommended: "+i.RecommendedDefault)
+	}
+	for _, entry := range i.RecommendedDefaultMap {
+		lines = append(lines, fmt.Sprintf("recommended: %s=%s", entry.GetKey(), entry.GetValue()))
+	}
+	return lines
+}
+
+// validation lists the field's buf.validate rules: whether it is required, the type-specific
+// constraints (e.g. string.min_len) and the message of each CEL expression.
+func (i *fieldInfo) validation() []string {
+	if i.Rules == nil {
+		return nil
+	}
+	var lines []string
+	if i.Required() {
+		lines = append(lines, "required")
+	}
+	if name, text := i.typeRules(); text != "" {
+		lines = append(lines, name+": "+text)
+	}
+	for _, rule := range i.Rules.GetCel() {
+		lines = append(lines, celRule(rule))
+	}
+	lines = append(lines, i.Rules.GetCelExpression()...)
+	return lines
+}
+
+// messageValidation lists the message-level CEL rules of md, which usually relate several fields.
+func messageValidation(md protoreflect.MessageDescriptor) []string {
+	opts := md.Options()
+	if opts == nil || !proto.HasExtension(opts, validate.E_Message) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(opts, validate.E_Message).(*validate.MessageRules)
+	var lines []string
+	for _, rule := range rules.GetCel() {
+		lines = append(lines, celRule(rule))
+	}
+	lines = append(lines, rules.GetCelExpression()...)
+	for _, oneof := range rules.GetOneof() {
+		line := "exactly one of: "
+		if !oneof.GetRequired() {
+			line = "at most one of: "
+		}
+		lines = append(lines, line+strings.Join(oneof.GetFields(), ", "))
+	}
+	return lines
+}
+
+// typeRules returns the name of the set type-specific rules message (e.g. "string") and its
+// constraints in compact text format.
+func (i *fieldInfo) typeRules() (string, string) {
+	name, rules := i.TypeRules()
+	if rules == nil {
+		return "", ""
+	}
+	text := prototext.MarshalOptions{}.Format(rules.Interface())
+	return string(name), strings.Join(strings.Fields(text), " ")
+}
+
+func celRule(rule *validate.Rule) string {
+	if rule.GetMessage() == "" {
+		return rule.GetExpression()
+	}
+	return fmt.Sprintf("%s (%s)", rule.GetMessage(), rule.GetId())
+}
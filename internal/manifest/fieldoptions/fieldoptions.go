@@ -0,0 +1,66 @@
+// Package fieldoptions reads the buf.validate rules and openmcf default options declared on
+// manifest fields. It is shared by the commands that describe or scaffold manifests.
+package fieldoptions
+
+import (
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	options_pb "github.com/plantonhq/openmcf/apis/org/openmcf/shared/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Options holds the validation rules and default options of a field.
+type Options struct {
+	Rules *validate.FieldRules
+	// Default is the options.default value, which is applied when the field is left unset
+	Default string
+	// RecommendedDefault is the options.recommended_default value, written by `openmcf new`
+	RecommendedDefault string
+	// RecommendedDefaultMap holds the options.recommended_default_map entries of a map field
+	RecommendedDefaultMap []*options_pb.KeyValuePair
+}
+
+// Of reads the options of field. Fields without options yield empty Options.
+func Of(field protoreflect.FieldDescriptor) *Options {
+	o := &Options{}
+	opts := field.Options()
+	if opts == nil {
+		return o
+	}
+	if proto.HasExtension(opts, validate.E_Field) {
+		o.Rules, _ = proto.GetExtension(opts, validate.E_Field).(*validate.FieldRules)
+	}
+	if proto.HasExtension(opts, options_pb.E_Default) {
+		o.Default, _ = proto.GetExtension(opts, options_pb.E_Default).(string)
+	}
+	if proto.HasExtension(opts, options_pb.E_RecommendedDefault) {
+		o.RecommendedDefault, _ = proto.GetExtension(opts, options_pb.E_RecommendedDefault).(string)
+	}
+	if proto.HasExtension(opts, options_pb.E_RecommendedDefaultMap) {
+		o.RecommendedDefaultMap, _ = proto.GetExtension(opts, options_pb.E_RecommendedDefaultMap).([]*options_pb.KeyValuePair)
+	}
+	return o
+}
+
+// Required reports whether validation rejects the field when it is left empty.
+func (o *Options) Required() bool {
+	return o.Rules.GetRequired() || o.Rules.GetRepeated().GetMinItems() > 0 || o.Rules.GetString_().GetMinLen() > 0
+}
+
+// TypeRules returns the name (e.g. "string") and message (e.g. StringRules) of the field's
+// type-specific rules, or an empty name and nil if none are set.
+func (o *Options) TypeRules() (protoreflect.Name, protoreflect.Message) {
+	if o.Rules == nil {
+		return "", nil
+	}
+	rules := o.Rules.ProtoReflect()
+	oneof := rules.Descriptor().Oneofs().ByName("type")
+	if oneof == nil {
+		return "", nil
+	}
+	member := rules.WhichOneof(oneof)
+	if member == nil || member.Message() == nil {
+		return "", nil
+	}
+	return member.Name(), rules.Get(member).Message()
+}
@@ -0,0 +1,50 @@
+package fieldoptions
+
+import (
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestOf(t *testing.T) {
+	fields := (&awsvpcv1.AwsVpcSpec{}).ProtoReflect().Descriptor().Fields()
+
+	subnets := Of(fields.ByName("subnets_per_availability_zone"))
+	assert.True(t, subnets.Required())
+	assert.Equal(t, "1", subnets.RecommendedDefault)
+
+	zones := Of(fields.ByName("availability_zones"))
+	assert.False(t, zones.Required())
+	assert.Nil(t, zones.Rules)
+}
+
+func TestRequired(t *testing.T) {
+	minLen := &Options{Rules: &validate.FieldRules{
+		Type: &validate.FieldRules_String_{String_: &validate.StringRules{MinLen: proto.Uint64(1)}},
+	}}
+	assert.True(t, minLen.Required())
+
+	minItems := &Options{Rules: &validate.FieldRules{
+		Type: &validate.FieldRules_Repeated{Repeated: &validate.RepeatedRules{MinItems: proto.Uint64(1)}},
+	}}
+	assert.True(t, minItems.Required())
+
+	assert.False(t, (&Options{}).Required())
+}
+
+func TestTypeRules(t *testing.T) {
+	o := &Options{Rules: &validate.FieldRules{
+		Type: &validate.FieldRules_String_{String_: &validate.StringRules{MinLen: proto.Uint64(3)}},
+	}}
+	name, rules := o.TypeRules()
+	assert.Equal(t, protoreflect.Name("string"), name)
+	assert.Equal(t, uint64(3), rules.Interface().(*validate.StringRules).GetMinLen())
+
+	name, rules = (&Options{}).TypeRules()
+	assert.Empty(t, name)
+	assert.Nil(t, rules)
+}
@@ -76,12 +76,15 @@ func LoadManifest(manifestPath string) (proto.Message, error) {
 
 	cloudResourceKind := crkreflect.KindFromString(kindName)
 
-	manifest := crkreflect.ToMessageMap[cloudResourceKind]
+	template := crkreflect.ToMessageMap[cloudResourceKind]
 
-	if manifest == nil {
+	if template == nil {
 		return nil, formatUnsupportedResourceError(kindName)
 	}
 
+	// Unmarshal into a new message so manifests of the same kind don't share one instance
+	manifest := template.ProtoReflect().New().Interface()
+
 	if err := protojson.Unmarshal(jsonBytes, manifest); err != nil {
 		return nil, &ManifestLoadError{ManifestPath: manifestPath, Err: err}
 	}
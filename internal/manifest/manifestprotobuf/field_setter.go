@@ -1,50 +1,291 @@
 package manifestprotobuf
 
 import (
+	"strings"
+
 	"github.com/iancoleman/strcase"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"strings"
 )
 
+// SetProtoField sets the value at fieldPath in msg and returns msg.
+//
+// Paths are dotted field names in snake_case or camelCase, with selectors for lists and maps:
+//
+//	spec.replicas=3                               scalar field
+//	spec.availabilityZones[1]=us-east-1b          list element (an index equal to the length appends)
+//	spec.availabilityZones[+]=us-east-1c          append; a list literal such as [a, b] appends each item
+//	spec.availabilityZones=[a, b]                 replace the whole list
+//	spec.env.variables.FOO=bar                    map entry; for scalar maps the rest of the path is the key
+//	spec.labels["app.kubernetes.io/name"]=x       map entry with a quoted key
+//	spec.subnets[0].cidr=10.0.1.0/24              field of a list element
+//	spec.container.resources={limits: {cpu: 1}}   message field from a JSON/YAML literal
+//	spec.ingress=null                             clear a field, list element or map entry
+//
+// String values are parsed as described above. Values of other types are set as-is on scalar fields.
 func SetProtoField(msg proto.Message, fieldPath string, value interface{}) (proto.Message, error) {
-	// Reflect over the provided proto.Message
-	msgReflect := msg.ProtoReflect()
+	tokens, err := parsePath(fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := setTokens(msg.ProtoReflect(), tokens, value); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func setTokens(msgReflect protoreflect.Message, tokens []token, value interface{}) error {
+	tok := tokens[0]
+	if tok.kind != tokenName {
+		return errors.Errorf("unexpected %s in message %s; expected a field name", tok, msgReflect.Descriptor().FullName())
+	}
+	fieldDescriptor, err := findField(msgReflect.Descriptor(), tok.text)
+	if err != nil {
+		return err
+	}
+	rest := tokens[1:]
 
-	// Split the field path by dot notation
-	fieldParts := strings.Split(fieldPath, ".")
+	switch {
+	case len(rest) == 0:
+		return setField(msgReflect, fieldDescriptor, value)
+	case fieldDescriptor.IsList():
+		return setListElement(msgReflect, fieldDescriptor, rest, value)
+	case fieldDescriptor.IsMap():
+		return setMapEntry(msgReflect, fieldDescriptor, rest, value)
+	case fieldDescriptor.Message() != nil:
+		// Step into the next nested message
+		return setTokens(msgReflect.Mutable(fieldDescriptor).Message(), rest, value)
+	default:
+		return errors.Errorf("field %s is a %s and has no field %s", fieldDescriptor.FullName(), fieldDescriptor.Kind(), rest[0])
+	}
+}
+
+// setField sets a whole field: a scalar, a message, a list or a map.
+func setField(msgReflect protoreflect.Message, fieldDescriptor protoreflect.FieldDescriptor, value interface{}) error {
+	raw, isString := value.(string)
+	if !isString {
+		if fieldDescriptor.IsList() || fieldDescriptor.IsMap() || fieldDescriptor.Message() != nil {
+			return errors.Errorf("setting %s requires a string value", fieldDescriptor.FullName())
+		}
+		return setProtoScalarField(msgReflect, fieldDescriptor, value)
+	}
+	if raw == nullValue {
+		msgReflect.Clear(fieldDescriptor)
+		return nil
+	}
+
+	switch {
+	case fieldDescriptor.IsList():
+		items, err := parseList(fieldDescriptor, raw)
+		if err != nil {
+			return err
+		}
+		msgReflect.Clear(fieldDescriptor)
+		return appendItems(msgReflect.Mutable(fieldDescriptor).List(), fieldDescriptor, items)
+	case fieldDescriptor.IsMap():
+		literal, err := parseLiteral(raw)
+		if err != nil {
+			return err
+		}
+		entries, ok := literal.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("field %s is a map; expected a JSON/YAML object like {key: value}", fieldDescriptor.FullName())
+		}
+		msgReflect.Clear(fieldDescriptor)
+		fieldMap := msgReflect.Mutable(fieldDescriptor).Map()
+		for key, entry := range entries {
+			mapKey, err := scalarFromString(fieldDescriptor.MapKey(), key)
+			if err != nil {
+				return errors.Wrapf(err, "invalid key %q for %s", key, fieldDescriptor.FullName())
+			}
+			mapValue, err := elementFromGeneric(fieldDescriptor.MapValue(), entry, func() protoreflect.Message {
+				return fieldMap.NewValue().Message()
+			})
+			if err != nil {
+				return err
+			}
+			fieldMap.Set(mapKey.MapKey(), mapValue)
+		}
+		return nil
+	default:
+		fieldValue, err := elementFromString(fieldDescriptor, raw, func() protoreflect.Message {
+			return msgReflect.NewField(fieldDescriptor).Message()
+		})
+		if err != nil {
+			return err
+		}
+		msgReflect.Set(fieldDescriptor, fieldValue)
+		return nil
+	}
+}
 
-	for i, part := range fieldParts {
-		// Try finding the field descriptor as-is
-		fieldDescriptor := msgReflect.Descriptor().Fields().ByName(protoreflect.Name(part))
+// setListElement applies an override whose path continues past a list field with [index] or [+].
+func setListElement(msgReflect protoreflect.Message, fieldDescriptor protoreflect.FieldDescriptor, tokens []token, value interface{}) error {
+	selector := tokens[0]
+	if selector.kind != tokenIndex && selector.kind != tokenAppend {
+		return errors.Errorf("field %s is a list; select an element with [index] or append with [+]", fieldDescriptor.FullName())
+	}
+	list := msgReflect.Mutable(fieldDescriptor).List()
+	index := list.Len()
+	if selector.kind == tokenIndex {
+		index = selector.index
+	}
+	if index > list.Len() {
+		return errors.Errorf("index %d is out of range for %s with %d element(s)", index, fieldDescriptor.FullName(), list.Len())
+	}
 
-		// If not found, attempt to convert the field to snake_case and retry
-		if fieldDescriptor == nil {
-			snakeCasePart := strcase.ToSnake(part)
-			fieldDescriptor = msgReflect.Descriptor().Fields().ByName(protoreflect.Name(snakeCasePart))
+	if len(tokens) > 1 {
+		if fieldDescriptor.Message() == nil {
+			return errors.Errorf("elements of %s are %s values and have no field %s", fieldDescriptor.FullName(), fieldDescriptor.Kind(), tokens[1])
+		}
+		var element protoreflect.Message
+		if index == list.Len() {
+			element = list.AppendMutable().Message()
+		} else {
+			element = list.Get(index).Message()
 		}
+		return setTokens(element, tokens[1:], value)
+	}
 
-		if fieldDescriptor == nil {
-			return nil, errors.Errorf("field %s not found in message %s", part, msgReflect.Descriptor().FullName())
+	raw, isString := value.(string)
+	if !isString {
+		return errors.Errorf("setting elements of %s requires a string value", fieldDescriptor.FullName())
+	}
+	if raw == nullValue {
+		if selector.kind == tokenAppend || index == list.Len() {
+			return errors.Errorf("no element to remove at %s%s", fieldDescriptor.Name(), selector)
+		}
+		removeListElement(list, index)
+		return nil
+	}
+	if selector.kind == tokenAppend {
+		items, err := parseList(fieldDescriptor, raw)
+		if err != nil {
+			return err
 		}
+		return appendItems(list, fieldDescriptor, items)
+	}
+	element, err := elementFromString(fieldDescriptor, raw, func() protoreflect.Message { return list.NewElement().Message() })
+	if err != nil {
+		return err
+	}
+	if index == list.Len() {
+		list.Append(element)
+	} else {
+		list.Set(index, element)
+	}
+	return nil
+}
 
-		if i == len(fieldParts)-1 {
-			// Set the last field
-			if !fieldDescriptor.IsList() && !fieldDescriptor.IsMap() {
-				// Handle scalar fields
-				if err := setProtoScalarField(msgReflect, fieldDescriptor, value); err != nil {
-					return nil, err
+// setMapEntry applies an override whose path continues past a map field with a key.
+func setMapEntry(msgReflect protoreflect.Message, fieldDescriptor protoreflect.FieldDescriptor, tokens []token, value interface{}) error {
+	key := tokens[0].text
+	rest := tokens[1:]
+	switch tokens[0].kind {
+	case tokenAppend:
+		return errors.Errorf("field %s is a map; select an entry with .key or [\"key\"]", fieldDescriptor.FullName())
+	case tokenName:
+		// Scalar maps cannot be descended into, so dotted keys need no quoting
+		if fieldDescriptor.MapValue().Message() == nil {
+			parts := []string{key}
+			for _, tok := range rest {
+				if tok.kind != tokenName {
+					return errors.Errorf("unexpected %s after map key %q of %s", tok, strings.Join(parts, "."), fieldDescriptor.FullName())
 				}
-			} else {
-				return nil, errors.Errorf("setting list or map fields is not supported")
+				parts = append(parts, tok.text)
 			}
-		} else {
-			// Step into the next nested message
-			msgReflect = msgReflect.Mutable(fieldDescriptor).Message()
+			key, rest = strings.Join(parts, "."), nil
 		}
 	}
-	return msg, nil
+
+	mapKeyValue, err := scalarFromString(fieldDescriptor.MapKey(), key)
+	if err != nil {
+		return errors.Wrapf(err, "invalid key %q for %s", key, fieldDescriptor.FullName())
+	}
+	mapKey := mapKeyValue.MapKey()
+	fieldMap := msgReflect.Mutable(fieldDescriptor).Map()
+
+	if len(rest) > 0 {
+		return setTokens(fieldMap.Mutable(mapKey).Message(), rest, value)
+	}
+
+	raw, isString := value.(string)
+	if !isString {
+		return errors.Errorf("setting entries of %s requires a string value", fieldDescriptor.FullName())
+	}
+	if raw == nullValue {
+		fieldMap.Clear(mapKey)
+		return nil
+	}
+	entry, err := elementFromString(fieldDescriptor.MapValue(), raw, func() protoreflect.Message { return fieldMap.NewValue().Message() })
+	if err != nil {
+		return err
+	}
+	fieldMap.Set(mapKey, entry)
+	return nil
+}
+
+// parseList decodes a list literal such as [a, b]. Any other value is a single item.
+func parseList(fieldDescriptor protoreflect.FieldDescriptor, raw string) ([]interface{}, error) {
+	if !strings.HasPrefix(strings.TrimSpace(raw), "[") {
+		if fieldDescriptor.Message() == nil {
+			return []interface{}{raw}, nil
+		}
+		literal, err := parseLiteral(raw)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{literal}, nil
+	}
+	literal, err := parseLiteral(raw)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := literal.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("field %s is a list; expected a value like [a, b]", fieldDescriptor.FullName())
+	}
+	return items, nil
+}
+
+func appendItems(list protoreflect.List, fieldDescriptor protoreflect.FieldDescriptor, items []interface{}) error {
+	for _, item := range items {
+		element, err := elementFromGeneric(fieldDescriptor, item, func() protoreflect.Message { return list.NewElement().Message() })
+		if err != nil {
+			return err
+		}
+		list.Append(element)
+	}
+	return nil
+}
+
+func removeListElement(list protoreflect.List, index int) {
+	for i := index; i < list.Len()-1; i++ {
+		list.Set(i, list.Get(i+1))
+	}
+	list.Truncate(list.Len() - 1)
+}
+
+// findField looks up a field by its proto name, JSON name or the snake_case form of name.
+// When none matches, the error names the closest field of the message.
+func findField(md protoreflect.MessageDescriptor, name string) (protoreflect.FieldDescriptor, error) {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd, nil
+	}
+	if fd := md.Fields().ByJSONName(name); fd != nil {
+		return fd, nil
+	}
+	// If not found, attempt to convert the field to snake_case and retry
+	if fd := md.Fields().ByName(protoreflect.Name(strcase.ToSnake(name))); fd != nil {
+		return fd, nil
+	}
+
+	err := errors.Errorf("field %s not found in message %s", name, md.FullName())
+	if closest := closestField(md, name); closest != nil {
+		err = errors.Errorf("field %s not found in message %s; did you mean %s?", name, md.FullName(), closest.JSONName())
+	}
+	return nil, err
 }
 
 func setProtoScalarField(msgReflect protoreflect.Message, fieldDescriptor protoreflect.FieldDescriptor, value interface{}) error {
@@ -1,10 +1,16 @@
 package manifestprotobuf
 
 import (
+	"testing"
+
+	awsalbv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsalb/v1"
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	kubernetesdaemonsetv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/kubernetes/kubernetesdaemonset/v1"
 	kubernetesredisv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/kubernetes/kubernetesredis/v1"
+	foreignkeyv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/foreignkey/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
-	"testing"
 )
 
 func TestSetProtoField(t *testing.T) {
@@ -62,3 +68,242 @@ func TestSetProtoField(t *testing.T) {
 		})
 	}
 }
+
+func stringValue(value string) *foreignkeyv1.StringValueOrRef {
+	return &foreignkeyv1.StringValueOrRef{LiteralOrRef: &foreignkeyv1.StringValueOrRef_Value{Value: value}}
+}
+
+func TestSetProtoFieldPaths(t *testing.T) {
+	vpc := func(zones ...string) *awsvpcv1.AwsVpc {
+		return &awsvpcv1.AwsVpc{Spec: &awsvpcv1.AwsVpcSpec{AvailabilityZones: zones}}
+	}
+	daemonSet := func(nodeSelector map[string]string) *kubernetesdaemonsetv1.KubernetesDaemonSet {
+		return &kubernetesdaemonsetv1.KubernetesDaemonSet{Spec: &kubernetesdaemonsetv1.KubernetesDaemonSetSpec{
+			NodeSelector: nodeSelector,
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		message   proto.Message
+		fieldPath string
+		value     interface{}
+		expected  proto.Message
+	}{
+		{
+			name:      "Set int field from a non-string value",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec.subnetsPerAvailabilityZone",
+			value:     int32(2),
+			expected:  &awsvpcv1.AwsVpc{Spec: &awsvpcv1.AwsVpcSpec{SubnetsPerAvailabilityZone: 2}},
+		},
+		{
+			name:      "Replace list element",
+			message:   vpc("a", "b"),
+			fieldPath: "spec.availabilityZones[1]",
+			value:     "c",
+			expected:  vpc("a", "c"),
+		},
+		{
+			name:      "Index equal to length appends",
+			message:   vpc("a"),
+			fieldPath: "spec.availability_zones[1]",
+			value:     "b",
+			expected:  vpc("a", "b"),
+		},
+		{
+			name:      "Append to list",
+			message:   vpc("a"),
+			fieldPath: "spec.availabilityZones[+]",
+			value:     "b",
+			expected:  vpc("a", "b"),
+		},
+		{
+			name:      "Append list literal",
+			message:   vpc("a"),
+			fieldPath: "spec.availabilityZones[+]",
+			value:     "[b, c]",
+			expected:  vpc("a", "b", "c"),
+		},
+		{
+			name:      "Replace whole list",
+			message:   vpc("a", "b"),
+			fieldPath: "spec.availabilityZones",
+			value:     "[c]",
+			expected:  vpc("c"),
+		},
+		{
+			name:      "Remove list element",
+			message:   vpc("a", "b", "c"),
+			fieldPath: "spec.availabilityZones[1]",
+			value:     "null",
+			expected:  vpc("a", "c"),
+		},
+		{
+			name:      "Clear field",
+			message:   vpc("a"),
+			fieldPath: "spec.availabilityZones",
+			value:     "null",
+			expected:  vpc(),
+		},
+		{
+			name:      "Set string field to the text null",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec.vpcCidr",
+			value:     `"null"`,
+			expected:  &awsvpcv1.AwsVpc{Spec: &awsvpcv1.AwsVpcSpec{VpcCidr: "null"}},
+		},
+		{
+			name:      "Set map entry with a dotted key",
+			message:   daemonSet(nil),
+			fieldPath: "spec.nodeSelector.kubernetes.io/os",
+			value:     "linux",
+			expected:  daemonSet(map[string]string{"kubernetes.io/os": "linux"}),
+		},
+		{
+			name:      "Set map entry with a quoted key",
+			message:   daemonSet(map[string]string{"a": "1"}),
+			fieldPath: `spec.nodeSelector["kubernetes.io/arch"]`,
+			value:     "arm64",
+			expected:  daemonSet(map[string]string{"a": "1", "kubernetes.io/arch": "arm64"}),
+		},
+		{
+			name:      "Delete map entry",
+			message:   daemonSet(map[string]string{"a": "1", "b": "2"}),
+			fieldPath: "spec.nodeSelector.a",
+			value:     "null",
+			expected:  daemonSet(map[string]string{"b": "2"}),
+		},
+		{
+			name:      "Replace whole map",
+			message:   daemonSet(map[string]string{"a": "1"}),
+			fieldPath: "spec.nodeSelector",
+			value:     "{b: 2}",
+			expected:  daemonSet(map[string]string{"b": "2"}),
+		},
+		{
+			name:      "Set map entry of a value-or-ref map",
+			message:   &kubernetesdaemonsetv1.KubernetesDaemonSet{},
+			fieldPath: "spec.container.app.env.variables.LOG_LEVEL",
+			value:     "debug",
+			expected: &kubernetesdaemonsetv1.KubernetesDaemonSet{Spec: &kubernetesdaemonsetv1.KubernetesDaemonSetSpec{
+				Container: &kubernetesdaemonsetv1.KubernetesDaemonSetContainer{
+					App: &kubernetesdaemonsetv1.KubernetesDaemonSetContainerApp{
+						Env: &kubernetesdaemonsetv1.KubernetesDaemonSetContainerAppEnv{
+							Variables: map[string]*foreignkeyv1.StringValueOrRef{"LOG_LEVEL": stringValue("debug")},
+						},
+					},
+				},
+			}},
+		},
+		{
+			name:      "Set field of a list element",
+			message:   &kubernetesdaemonsetv1.KubernetesDaemonSet{},
+			fieldPath: "spec.tolerations[0].key",
+			value:     "dedicated",
+			expected: &kubernetesdaemonsetv1.KubernetesDaemonSet{Spec: &kubernetesdaemonsetv1.KubernetesDaemonSetSpec{
+				Tolerations: []*kubernetesdaemonsetv1.KubernetesDaemonSetToleration{{Key: "dedicated"}},
+			}},
+		},
+		{
+			name:      "Append message literal",
+			message:   &kubernetesdaemonsetv1.KubernetesDaemonSet{},
+			fieldPath: "spec.tolerations[+]",
+			value:     `{key: dedicated, operator: Exists, tolerationSeconds: 30}`,
+			expected: &kubernetesdaemonsetv1.KubernetesDaemonSet{Spec: &kubernetesdaemonsetv1.KubernetesDaemonSetSpec{
+				Tolerations: []*kubernetesdaemonsetv1.KubernetesDaemonSetToleration{
+					{Key: "dedicated", Operator: "Exists", TolerationSeconds: 30},
+				},
+			}},
+		},
+		{
+			name:      "Set message field from a JSON literal",
+			message:   &awsalbv1.AwsAlb{},
+			fieldPath: "spec.dns",
+			value:     `{"enabled": true, "hostnames": ["a.example.com"]}`,
+			expected: &awsalbv1.AwsAlb{Spec: &awsalbv1.AwsAlbSpec{
+				Dns: &awsalbv1.AwsAlbDns{Enabled: true, Hostnames: []string{"a.example.com"}},
+			}},
+		},
+		{
+			name:      "Set value-or-ref list from literals",
+			message:   &awsalbv1.AwsAlb{},
+			fieldPath: "spec.subnets",
+			value:     "[subnet-a, subnet-b]",
+			expected: &awsalbv1.AwsAlb{Spec: &awsalbv1.AwsAlbSpec{
+				Subnets: []*foreignkeyv1.StringValueOrRef{stringValue("subnet-a"), stringValue("subnet-b")},
+			}},
+		},
+		{
+			name:      "Set value-or-ref field from a literal",
+			message:   &awsalbv1.AwsAlb{},
+			fieldPath: "spec.dns.route53ZoneId",
+			value:     "Z123",
+			expected: &awsalbv1.AwsAlb{Spec: &awsalbv1.AwsAlbSpec{
+				Dns: &awsalbv1.AwsAlbDns{Route53ZoneId: stringValue("Z123")},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SetProtoField(tt.message, tt.fieldPath, tt.value)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, result), "Expected %v but got %v", tt.expected, result)
+		})
+	}
+}
+
+func TestSetProtoFieldErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   proto.Message
+		fieldPath string
+		value     interface{}
+		errText   string
+	}{
+		{
+			name:      "Unknown field suggests the closest one",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec.availabilityZone",
+			value:     "a",
+			errText:   "did you mean availabilityZones?",
+		},
+		{
+			name:      "Index out of range",
+			message:   &awsvpcv1.AwsVpc{Spec: &awsvpcv1.AwsVpcSpec{AvailabilityZones: []string{"a"}}},
+			fieldPath: "spec.availabilityZones[3]",
+			value:     "b",
+			errText:   "out of range",
+		},
+		{
+			name:      "Descending into a scalar",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec.vpcCidr.value",
+			value:     "a",
+			errText:   "has no field value",
+		},
+		{
+			name:      "Invalid int value",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec.subnetSize",
+			value:     "large",
+			errText:   "large",
+		},
+		{
+			name:      "Invalid path",
+			message:   &awsvpcv1.AwsVpc{},
+			fieldPath: "spec..vpcCidr",
+			value:     "a",
+			errText:   "empty segment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SetProtoField(tt.message, tt.fieldPath, tt.value)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errText)
+		})
+	}
+}
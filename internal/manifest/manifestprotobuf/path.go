@@ -0,0 +1,113 @@
+package manifestprotobuf
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type tokenKind int
+
+const (
+	// tokenName is a dotted path segment: a field name, or a map key
+	tokenName tokenKind = iota
+	// tokenIndex is a list index, e.g. [2]
+	tokenIndex
+	// tokenAppend appends to a list: [+]
+	tokenAppend
+	// tokenKey is a bracketed map key, e.g. ["app.kubernetes.io/name"]
+	tokenKey
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	index int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenIndex:
+		return "[" + strconv.Itoa(t.index) + "]"
+	case tokenAppend:
+		return "[+]"
+	case tokenKey:
+		return strconv.Quote(t.text)
+	default:
+		return t.text
+	}
+}
+
+// parsePath splits an override path such as spec.zones[1], spec.labels["a.b/c"] or
+// spec.subnets[+].cidr into tokens.
+func parsePath(path string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			if i == 0 || i == len(path)-1 || path[i+1] == '.' {
+				return nil, errors.Errorf("invalid path %q: empty segment", path)
+			}
+			i++
+		case '[':
+			end, tok, err := parseBracket(path, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = end
+		default:
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenName, text: path[i:end]})
+			i = end
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty path")
+	}
+	return tokens, nil
+}
+
+// parseBracket parses the selector starting at path[start] == '[' and returns the
+// position after its closing bracket.
+func parseBracket(path string, start int) (int, token, error) {
+	i := start + 1
+	if i < len(path) && (path[i] == '"' || path[i] == '\'') {
+		quote := path[i]
+		closing := strings.IndexByte(path[i+1:], quote)
+		if closing < 0 {
+			return 0, token{}, errors.Errorf("invalid path %q: unterminated quote", path)
+		}
+		key := path[i+1 : i+1+closing]
+		i += closing + 2
+		if i >= len(path) || path[i] != ']' {
+			return 0, token{}, errors.Errorf("invalid path %q: expected ] after quoted key", path)
+		}
+		return i + 1, token{kind: tokenKey, text: key}, nil
+	}
+
+	closing := strings.IndexByte(path[i:], ']')
+	if closing < 0 {
+		return 0, token{}, errors.Errorf("invalid path %q: missing ]", path)
+	}
+	content := path[i : i+closing]
+	end := i + closing + 1
+	switch {
+	case content == "":
+		return 0, token{}, errors.Errorf("invalid path %q: empty []", path)
+	case content == "+":
+		return end, token{kind: tokenAppend}, nil
+	default:
+		if index, err := strconv.Atoi(content); err == nil {
+			if index < 0 {
+				return 0, token{}, errors.Errorf("invalid path %q: negative index", path)
+			}
+			return end, token{kind: tokenIndex, index: index, text: content}, nil
+		}
+		return end, token{kind: tokenKey, text: content}, nil
+	}
+}
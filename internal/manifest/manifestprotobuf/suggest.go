@@ -0,0 +1,51 @@
+package manifestprotobuf
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// closestField returns the field of md whose proto or JSON name is closest to name
+// by edit distance, ignoring case and underscores.
+func closestField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	var closest protoreflect.FieldDescriptor
+	best := -1
+	target := normalizeName(name)
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		for _, candidate := range []string{string(fd.Name()), fd.JSONName()} {
+			distance := editDistance(target, normalizeName(candidate))
+			if best < 0 || distance < best {
+				closest, best = fd, distance
+			}
+		}
+	}
+	return closest
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
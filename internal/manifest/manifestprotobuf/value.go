@@ -0,0 +1,116 @@
+package manifestprotobuf
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest/protodefaults"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sigs.k8s.io/yaml"
+)
+
+// nullValue clears the field, list element or map entry it is assigned to.
+const nullValue = "null"
+
+// parseLiteral decodes a JSON/YAML literal into generic values
+// (string, float64, bool, []interface{}, map[string]interface{}).
+func parseLiteral(raw string) (interface{}, error) {
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, errors.Wrapf(err, "invalid JSON/YAML value %q", raw)
+	}
+	return value, nil
+}
+
+// scalarFromString converts the raw text of an override to a scalar field value.
+// Strings are taken verbatim, except that a JSON-quoted string is unquoted, which
+// allows setting a string field to the text "null".
+func scalarFromString(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	if field.Kind() == protoreflect.StringKind {
+		if len(raw) >= 2 && strings.HasPrefix(raw, `"`) && strings.HasSuffix(raw, `"`) {
+			if unquoted, err := strconv.Unquote(raw); err == nil {
+				return protoreflect.ValueOfString(unquoted), nil
+			}
+		}
+		return protoreflect.ValueOfString(raw), nil
+	}
+	if field.Kind() == protoreflect.EnumKind {
+		if number, err := strconv.Atoi(raw); err == nil {
+			if enumValue := field.Enum().Values().ByNumber(protoreflect.EnumNumber(number)); enumValue != nil {
+				return protoreflect.ValueOfEnum(enumValue.Number()), nil
+			}
+		}
+	}
+	return protodefaults.ConvertStringToFieldValue(strings.TrimSpace(raw), field)
+}
+
+// elementFromString converts the raw text of an override to a single value of field,
+// which is a singular field, a list element or a map value. newMessage returns the empty
+// message to decode into when the field is a message.
+func elementFromString(field protoreflect.FieldDescriptor, raw string, newMessage func() protoreflect.Message) (protoreflect.Value, error) {
+	if field.Message() == nil {
+		return scalarFromString(field, raw)
+	}
+	value, err := parseLiteral(raw)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return elementFromGeneric(field, value, newMessage)
+}
+
+// elementFromGeneric converts a decoded JSON/YAML value to a single value of field.
+func elementFromGeneric(field protoreflect.FieldDescriptor, value interface{}, newMessage func() protoreflect.Message) (protoreflect.Value, error) {
+	if field.Message() == nil {
+		switch v := value.(type) {
+		case string:
+			return scalarFromString(field, v)
+		case float64:
+			return scalarFromString(field, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			return scalarFromString(field, strconv.FormatBool(v))
+		default:
+			return protoreflect.Value{}, errors.Errorf("expected a %s value for %s", field.Kind(), field.FullName())
+		}
+	}
+
+	msg := newMessage()
+	if _, isObject := value.(map[string]interface{}); !isObject {
+		// foreignkey <Type>ValueOrRef messages accept their literal directly
+		literal := literalField(msg.Descriptor())
+		if literal == nil {
+			return protoreflect.Value{}, errors.Errorf("expected a JSON/YAML object for %s (%s)",
+				field.FullName(), msg.Descriptor().FullName())
+		}
+		literalValue, err := elementFromGeneric(literal, value, nil)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		msg.Set(literal, literalValue)
+		return protoreflect.ValueOfMessage(msg), nil
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return protoreflect.Value{}, errors.Wrap(err, "failed to encode value")
+	}
+	if err := protojson.Unmarshal(jsonBytes, msg.Interface()); err != nil {
+		return protoreflect.Value{}, errors.Wrapf(err, "invalid value for %s (%s)", field.FullName(), msg.Descriptor().FullName())
+	}
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// literalField returns the literal member of a foreignkey <Type>ValueOrRef message, if md is one.
+func literalField(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	oneof := md.Oneofs().ByName("literal_or_ref")
+	if oneof == nil {
+		return nil
+	}
+	field := oneof.Fields().ByName("value")
+	if field == nil || field.Message() != nil {
+		return nil
+	}
+	return field
+}
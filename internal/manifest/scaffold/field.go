@@ -0,0 +1,103 @@
+package scaffold
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/plantonhq/openmcf/internal/manifest/fieldoptions"
+	"github.com/plantonhq/openmcf/internal/manifest/protodefaults"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldInfo collects the field options the scaffold is built from.
+type fieldInfo struct {
+	*fieldoptions.Options
+}
+
+func newFieldInfo(field protoreflect.FieldDescriptor) *fieldInfo {
+	return &fieldInfo{fieldoptions.Of(field)}
+}
+
+// placeholder returns a value satisfying the field's rules where they name one
+// (const, example, in, gte), and otherwise the zero value of the field.
+func (i *fieldInfo) placeholder(field protoreflect.FieldDescriptor) protoreflect.Value {
+	if _, typeRules := i.TypeRules(); typeRules != nil {
+		for _, name := range []protoreflect.Name{"const", "example", "in", "gte"} {
+			ruleField := typeRules.Descriptor().Fields().ByName(name)
+			if ruleField == nil || !typeRules.Has(ruleField) {
+				continue
+			}
+			value := typeRules.Get(ruleField)
+			if ruleField.IsList() {
+				value = value.List().Get(0)
+			}
+			if field.Kind() == protoreflect.EnumKind {
+				// Enum rules name values by number
+				if enumValue := field.Enum().Values().ByNumber(protoreflect.EnumNumber(value.Int())); enumValue != nil {
+					return protoreflect.ValueOfEnum(enumValue.Number())
+				}
+				continue
+			}
+			if converted, err := protodefaults.ConvertStringToFieldValue(fmt.Sprint(value.Interface()), field); err == nil {
+				return converted
+			}
+		}
+	}
+
+	if field.Kind() == protoreflect.EnumKind {
+		// Required enums reject the zero value, so suggest the first real one
+		values := field.Enum().Values()
+		for n := 0; n < values.Len(); n++ {
+			if values.Get(n).Number() != 0 {
+				return protoreflect.ValueOfEnum(values.Get(n).Number())
+			}
+		}
+	}
+	if field.Cardinality() == protoreflect.Repeated {
+		// Default is only defined for singular fields
+		return zeroValue(field)
+	}
+	return field.Default()
+}
+
+func zeroValue(field protoreflect.FieldDescriptor) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString("")
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(nil)
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(false)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(0)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(0)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(0)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(0)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(0)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(0)
+	default:
+		return protoreflect.ValueOfInt32(0)
+	}
+}
+
+// hint summarizes the field's validation rules for the trailing comment of a required field.
+func (i *fieldInfo) hint() string {
+	parts := []string{"required"}
+	if _, typeRules := i.TypeRules(); typeRules != nil {
+		if text := strings.TrimSpace(prototext.MarshalOptions{}.Format(typeRules.Interface())); text != "" {
+			parts = append(parts, strings.Join(strings.Fields(text), " "))
+		}
+	}
+	for _, rule := range i.Rules.GetCel() {
+		if rule.GetMessage() != "" {
+			parts = append(parts, rule.GetMessage())
+		}
+	}
+	return strings.Join(parts, "; ")
+}
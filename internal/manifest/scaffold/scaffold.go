@@ -0,0 +1,362 @@
+// Package scaffold generates commented YAML skeletons of cloud resource manifests
+// from the proto descriptors of their kinds.
+package scaffold
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"github.com/plantonhq/openmcf/internal/manifest/fieldoptions"
+	"github.com/plantonhq/openmcf/internal/manifest/protodefaults"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/iac/provisionerlabels"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sigs.k8s.io/yaml"
+)
+
+// Options customize the generated manifest.
+type Options struct {
+	// Name, Org and Env populate the manifest metadata when set
+	Name string
+	Org  string
+	Env  string
+
+	// Describe returns the documentation of a field, written as a comment above it.
+	// It is optional; fields are left undocumented when it is nil or returns "".
+	Describe func(messageFullName protoreflect.FullName, fieldName protoreflect.Name) string
+
+	// Prompt, when set, is asked for the value of every required scalar field that has no
+	// recommended default. An empty answer keeps the placeholder.
+	Prompt func(field *PromptField) (string, error)
+}
+
+// PromptField describes a required field the user is asked to fill in.
+type PromptField struct {
+	// Path is the proto field path, e.g. "spec.vpc_cidr"
+	Path string
+	// Hint summarizes the field's validation rules
+	Hint string
+	// Placeholder is the value written when the answer is empty
+	Placeholder string
+	// Error is set when the previous answer could not be converted to the field's type
+	Error error
+}
+
+// Generate returns a YAML manifest of the given kind. Fields that are required or have a
+// recommended default are set; other fields are written as comments to uncomment as needed.
+func Generate(kind cloudresourcekind.CloudResourceKind, opts Options) ([]byte, error) {
+	msg, err := crkreflect.NewInstance(kind)
+	if err != nil {
+		return nil, err
+	}
+	specField := msg.ProtoReflect().Descriptor().Fields().ByName("spec")
+	if specField == nil || specField.Message() == nil {
+		return nil, errors.Errorf("%s has no spec message", kind)
+	}
+	kindName := crkreflect.ExtractKindNameByKind(kind)
+
+	g := &generator{opts: opts}
+	g.comment(0, fmt.Sprintf("%s manifest generated by `openmcf new`.", kindName))
+	g.comment(0, `Values marked "required" must be filled in; recommended defaults can be adjusted.`)
+	g.comment(0, "Commented fields are optional: uncomment them to set a value.")
+	g.emit(0, false, "apiVersion: "+crkreflect.GroupVersion(kind))
+	g.emit(0, false, "kind: "+kindName)
+	if err := g.metadata(); err != nil {
+		return nil, err
+	}
+	g.emit(0, false, "spec:")
+	if err := g.message(specField.Message(), 1, false, "spec", nil); err != nil {
+		return nil, err
+	}
+	return []byte(strings.Join(g.lines, "\n") + "\n"), nil
+}
+
+type generator struct {
+	opts  Options
+	lines []string
+}
+
+func (g *generator) emit(indent int, commented bool, text string) {
+	prefix := strings.Repeat("  ", indent)
+	if commented {
+		prefix += "# "
+	}
+	g.lines = append(g.lines, prefix+text)
+}
+
+func (g *generator) comment(indent int, text string) {
+	g.emit(indent, true, text)
+}
+
+func (g *generator) metadata() error {
+	name := g.opts.Name
+	if name == "" && g.opts.Prompt != nil {
+		answer, err := g.opts.Prompt(&PromptField{Path: "metadata.name", Hint: "required"})
+		if err != nil {
+			return err
+		}
+		name = strings.TrimSpace(answer)
+	}
+
+	g.emit(0, false, "metadata:")
+	if name == "" {
+		g.emit(1, false, `name: ""  # required`)
+	} else {
+		g.emit(1, false, "name: "+yamlScalar(name))
+	}
+	for _, entry := range []struct{ key, value string }{{"org", g.opts.Org}, {"env", g.opts.Env}} {
+		if entry.value == "" {
+			g.emit(1, true, entry.key+`: ""`)
+		} else {
+			g.emit(1, false, entry.key+": "+yamlScalar(entry.value))
+		}
+	}
+	g.emit(1, true, "labels:")
+	g.emit(2, true, fmt.Sprintf("%s: pulumi  # pulumi, tofu or terraform", provisionerlabels.ProvisionerLabelKey))
+	return nil
+}
+
+// message writes the fields of md. Inside a commented (optional) message only fields that
+// would be set are written, which keeps large specs readable.
+func (g *generator) message(md protoreflect.MessageDescriptor, indent int, commented bool, path string,
+	stack []protoreflect.FullName) error {
+	for _, seen := range stack {
+		if seen == md.FullName() {
+			return nil
+		}
+	}
+	stack = append(stack, md.FullName())
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		info := newFieldInfo(field)
+		isSet := info.Required() || info.RecommendedDefault != "" || len(info.RecommendedDefaultMap) > 0
+		if commented && !isSet {
+			continue
+		}
+		if !commented {
+			g.describe(md, field, indent)
+		}
+		if err := g.field(field, info, indent, commented || !isSet, path+"."+string(field.Name()), stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) describe(md protoreflect.MessageDescriptor, field protoreflect.FieldDescriptor, indent int) {
+	if g.opts.Describe == nil {
+		return
+	}
+	description := strings.TrimSpace(g.opts.Describe(md.FullName(), field.Name()))
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(description, "\n") {
+		g.comment(indent, strings.TrimSpace(line))
+	}
+}
+
+func (g *generator) field(field protoreflect.FieldDescriptor, info *fieldInfo, indent int, commented bool,
+	path string, stack []protoreflect.FullName) error {
+	key := field.JSONName()
+
+	switch {
+	case field.IsMap():
+		if len(info.RecommendedDefaultMap) == 0 {
+			g.emit(indent, commented, key+": {}")
+			return nil
+		}
+		g.emit(indent, commented, key+":")
+		for _, entry := range info.RecommendedDefaultMap {
+			value, err := protodefaults.ConvertStringToFieldValue(entry.GetValue(), field.MapValue())
+			if err != nil {
+				continue
+			}
+			g.emit(indent+1, commented, yamlScalar(entry.GetKey())+": "+valueYaml(field.MapValue(), value))
+		}
+	case field.IsList():
+		if !info.Required() {
+			g.emit(indent, commented, key+": []")
+			return nil
+		}
+		g.emit(indent, commented, key+":")
+		itemInfo := &fieldInfo{&fieldoptions.Options{Rules: info.Rules.GetRepeated().GetItems()}}
+		if field.Message() == nil {
+			g.emit(indent+1, commented, "- "+valueYaml(field, itemInfo.placeholder(field)))
+			return nil
+		}
+		if valueField := literalField(field.Message()); valueField != nil {
+			g.emit(indent+1, commented, "- value: "+valueYaml(valueField, itemInfo.placeholder(valueField)))
+			return nil
+		}
+		return g.listItem(field.Message(), indent+1, commented, path+".0", stack)
+	case field.Message() != nil:
+		if valueField := literalField(field.Message()); valueField != nil {
+			// StringValueOrRef and friends take the literal as {value: ...}
+			g.emit(indent, commented, key+":")
+			return g.scalar(valueField, info, indent+1, commented, path)
+		}
+		start := len(g.lines)
+		g.emit(indent, commented, key+":")
+		if err := g.message(field.Message(), indent+1, commented, path, stack); err != nil {
+			return err
+		}
+		if len(g.lines) == start+1 {
+			g.lines[start] += " {}"
+		}
+	default:
+		return g.scalar(field, info, indent, commented, path)
+	}
+	return nil
+}
+
+// listItem writes a single element of a repeated message field as a YAML sequence entry.
+func (g *generator) listItem(md protoreflect.MessageDescriptor, indent int, commented bool, path string,
+	stack []protoreflect.FullName) error {
+	outer := g.lines
+	g.lines = nil
+	if err := g.message(md, indent+1, commented, path, stack); err != nil {
+		return err
+	}
+	item := g.lines
+	g.lines = outer
+
+	prefix, dash := strings.Repeat("  ", indent+1), strings.Repeat("  ", indent)
+	if commented {
+		prefix += "# "
+		dash += "# "
+	}
+	for i, line := range item {
+		// The dash goes on the first field written at the item's own indentation
+		rest := strings.TrimPrefix(line, prefix)
+		if strings.HasPrefix(line, prefix) && rest != "" && rest[0] != ' ' && rest[0] != '#' {
+			item[i] = dash + "- " + rest
+			g.lines = append(g.lines, item...)
+			return nil
+		}
+	}
+	g.lines = append(g.lines, item...)
+	g.emit(indent, commented, "- {}")
+	return nil
+}
+
+func (g *generator) scalar(field protoreflect.FieldDescriptor, info *fieldInfo, indent int, commented bool, path string) error {
+	key := field.JSONName()
+
+	var value protoreflect.Value
+	var notes []string
+	switch {
+	case info.RecommendedDefault != "":
+		converted, err := protodefaults.ConvertStringToFieldValue(info.RecommendedDefault, field)
+		if err != nil {
+			// Keep the option visible even when it does not match the field's type
+			value = info.placeholder(field)
+			notes = append(notes, "recommended: "+info.RecommendedDefault)
+			break
+		}
+		value = converted
+	case info.Default != "":
+		converted, err := protodefaults.ConvertStringToFieldValue(info.Default, field)
+		if err != nil {
+			value = info.placeholder(field)
+			notes = append(notes, "default: "+info.Default)
+			break
+		}
+		value = converted
+		notes = append(notes, "default")
+	case info.Required():
+		value = info.placeholder(field)
+		if g.opts.Prompt != nil && !commented {
+			answered, ok, err := g.ask(field, info, path, value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				value = answered
+				break
+			}
+		}
+		notes = append(notes, info.hint())
+	default:
+		value = field.Default()
+	}
+
+	if field.Kind() == protoreflect.EnumKind {
+		notes = append(notes, "one of: "+strings.Join(enumNames(field.Enum()), ", "))
+	}
+	line := key + ": " + valueYaml(field, value)
+	if len(notes) > 0 {
+		line += "  # " + strings.Join(notes, "; ")
+	}
+	g.emit(indent, commented, line)
+	return nil
+}
+
+// ask prompts for a required field until the answer converts to the field's type.
+// It reports false when the answer is empty.
+func (g *generator) ask(field protoreflect.FieldDescriptor, info *fieldInfo, path string,
+	placeholder protoreflect.Value) (protoreflect.Value, bool, error) {
+	promptField := &PromptField{Path: path, Hint: info.hint(), Placeholder: valueYaml(field, placeholder)}
+	for {
+		answer, err := g.opts.Prompt(promptField)
+		if err != nil {
+			return protoreflect.Value{}, false, errors.Wrapf(err, "failed to read %s", path)
+		}
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			return protoreflect.Value{}, false, nil
+		}
+		value, err := protodefaults.ConvertStringToFieldValue(answer, field)
+		if err == nil {
+			return value, true, nil
+		}
+		promptField.Error = err
+	}
+}
+
+// literalField returns the literal member of a foreignkey <Type>ValueOrRef message, if md is one.
+func literalField(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	oneof := md.Oneofs().ByName("literal_or_ref")
+	if oneof == nil {
+		return nil
+	}
+	field := oneof.Fields().ByName("value")
+	if field == nil || field.Message() != nil {
+		return nil
+	}
+	return field
+}
+
+func enumNames(ed protoreflect.EnumDescriptor) []string {
+	var names []string
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		if values.Get(i).Number() != 0 {
+			names = append(names, string(values.Get(i).Name()))
+		}
+	}
+	return names
+}
+
+func valueYaml(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if field.Kind() == protoreflect.EnumKind {
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return fmt.Sprint(value.Enum())
+	}
+	return yamlScalar(value.Interface())
+}
+
+// yamlScalar encodes a single value, quoting strings that YAML would otherwise read as another type.
+func yamlScalar(value interface{}) string {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,60 @@
+package scaffold
+
+import (
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+func TestGenerate_AppliesRecommendedDefaults(t *testing.T) {
+	out, err := Generate(cloudresourcekind.CloudResourceKind_AwsVpc, Options{Name: "main"})
+	require.NoError(t, err)
+
+	jsonBytes, err := yaml.YAMLToJSON(out)
+	require.NoError(t, err)
+	vpc := &awsvpcv1.AwsVpc{}
+	require.NoError(t, protojson.Unmarshal(jsonBytes, vpc))
+
+	assert.Equal(t, "AwsVpc", vpc.Kind)
+	assert.Equal(t, "main", vpc.Metadata.Name)
+	assert.Equal(t, int32(1), vpc.Spec.SubnetsPerAvailabilityZone)
+	assert.Contains(t, string(out), `vpcCidr: ""  # required`)
+	assert.Contains(t, string(out), "# isNatGatewayEnabled: false")
+}
+
+func TestGenerate_PromptsForRequiredFields(t *testing.T) {
+	var asked []string
+	out, err := Generate(cloudresourcekind.CloudResourceKind_AwsVpc, Options{
+		Name: "main",
+		Prompt: func(field *PromptField) (string, error) {
+			asked = append(asked, field.Path)
+			return "10.0.0.0/16", nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"spec.vpc_cidr"}, asked)
+	assert.Contains(t, string(out), "vpcCidr: 10.0.0.0/16\n")
+}
+
+func TestGenerate_AllKindsParse(t *testing.T) {
+	for _, kind := range crkreflect.KindsList() {
+		if crkreflect.ToMessageMap[kind] == nil {
+			continue
+		}
+		out, err := Generate(kind, Options{Name: "main"})
+		require.NoError(t, err, kind.String())
+
+		jsonBytes, err := yaml.YAMLToJSON(out)
+		require.NoError(t, err, kind.String())
+		msg, err := crkreflect.NewInstance(kind)
+		require.NoError(t, err)
+		assert.NoError(t, protojson.Unmarshal(jsonBytes, msg), "%s:\n%s", kind, out)
+	}
+}
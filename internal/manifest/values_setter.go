@@ -12,7 +12,15 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
-func LoadWithOverrides(manifestPath string, valueOverrides map[string]string) (proto.Message, error) {
+// Override sets the manifest field at Path to Value, e.g. spec.zones[+]=us-east-1c.
+type Override struct {
+	Path  string
+	Value string
+}
+
+// LoadWithOverrides loads the manifest and applies the overrides in order. Overrides are
+// not idempotent (spec.zones[+]=a appends on every call), so apply them to a manifest once.
+func LoadWithOverrides(manifestPath string, valueOverrides []Override) (proto.Message, error) {
 	manifest, err := LoadManifest(manifestPath)
 	if err != nil {
 		// Preserve ManifestLoadError type for beautiful error display
@@ -21,10 +29,10 @@ func LoadWithOverrides(manifestPath string, valueOverrides map[string]string) (p
 		}
 		return nil, errors.Wrap(err, "failed to load manifest")
 	}
-	for key, value := range valueOverrides {
-		manifest, err = manifestprotobuf.SetProtoField(manifest, key, value)
+	for _, override := range valueOverrides {
+		manifest, err = manifestprotobuf.SetProtoField(manifest, override.Path, override.Value)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to set %s=%s", key, value)
+			return nil, errors.Wrapf(err, "failed to set %s=%s", override.Path, override.Value)
 		}
 	}
 	return manifest, nil
@@ -33,7 +41,7 @@ func LoadWithOverrides(manifestPath string, valueOverrides map[string]string) (p
 // ApplyOverridesToFile loads a manifest, applies overrides, and writes to a new temp file.
 // Returns the path to the new temp file and whether it's a temp file that needs cleanup.
 // If no overrides are provided, returns the original path unchanged.
-func ApplyOverridesToFile(manifestPath string, valueOverrides map[string]string) (string, bool, error) {
+func ApplyOverridesToFile(manifestPath string, valueOverrides []Override) (string, bool, error) {
 	// If no overrides, return original path
 	if len(valueOverrides) == 0 {
 		return manifestPath, false, nil
@@ -45,30 +53,59 @@ func ApplyOverridesToFile(manifestPath string, valueOverrides map[string]string)
 		return "", false, formatOverrideError(err)
 	}
 
-	// Convert to YAML
-	jsonBytes, err := protojson.Marshal(manifest)
+	tempFilePath, err := WriteToTempFile(manifest, "manifest-with-overrides-*.yaml")
 	if err != nil {
-		return "", false, errors.Wrap(err, "failed to marshal manifest to json")
+		return "", false, errors.Wrap(err, "failed to write manifest with overrides")
 	}
 
-	yamlBytes, err := yaml.JSONToYAML(jsonBytes)
+	return tempFilePath, true, nil
+}
+
+// WriteToTempFile writes the manifest as YAML to a new temp file created with the given
+// name pattern and returns its path. The caller is responsible for removing the file.
+func WriteToTempFile(manifest proto.Message, pattern string) (string, error) {
+	yamlBytes, err := toYaml(manifest)
 	if err != nil {
-		return "", false, errors.Wrap(err, "failed to convert json to yaml")
+		return "", err
 	}
 
-	// Write to temp file
-	tempFile, err := os.CreateTemp("", "manifest-with-overrides-*.yaml")
+	tempFile, err := os.CreateTemp("", pattern)
 	if err != nil {
-		return "", false, errors.Wrap(err, "failed to create temp file for overrides")
+		return "", errors.Wrap(err, "failed to create temp file")
 	}
 	defer tempFile.Close()
 
 	if _, err := tempFile.Write(yamlBytes); err != nil {
 		os.Remove(tempFile.Name())
-		return "", false, errors.Wrap(err, "failed to write manifest with overrides")
+		return "", errors.Wrap(err, "failed to write manifest")
+	}
+
+	return tempFile.Name(), nil
+}
+
+// WriteToFile writes the manifest as YAML to the given path, replacing any existing file.
+func WriteToFile(manifest proto.Message, path string) error {
+	yamlBytes, err := toYaml(manifest)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, yamlBytes, 0o644); err != nil {
+		return errors.Wrapf(err, "failed to write manifest to %s", path)
 	}
+	return nil
+}
 
-	return tempFile.Name(), true, nil
+func toYaml(manifest proto.Message) ([]byte, error) {
+	jsonBytes, err := protojson.Marshal(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal manifest to json")
+	}
+
+	yamlBytes, err := yaml.JSONToYAML(jsonBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert json to yaml")
+	}
+	return yamlBytes, nil
 }
 
 func formatOverrideError(err error) error {
@@ -98,20 +135,24 @@ func formatOverrideError(err error) error {
 	// Provide helpful guidance
 	msg.WriteString(bold("💡 Common Issues:\n\n"))
 
-	if strings.Contains(errMsg, "unsupported field type") {
-		msg.WriteString("   You're trying to override a complex/nested field with a simple value.\n")
-		msg.WriteString("   For nested fields, you need to specify the complete path:\n\n")
-		msg.WriteString(green("   # Wrong:\n"))
-		msg.WriteString(green("   --set spec.container.app.image=nginx\n\n"))
-		msg.WriteString(green("   # Correct:\n"))
-		msg.WriteString(green("   --set spec.container.app.image.repo=nginx\n"))
-		msg.WriteString(green("   --set spec.container.app.image.tag=latest\n"))
-	} else if strings.Contains(errMsg, "field not found") {
+	if strings.Contains(errMsg, "requires a string value") || strings.Contains(errMsg, "expected a JSON/YAML") {
+		msg.WriteString("   Lists, maps and messages are set with a JSON/YAML literal, or one element at a time:\n\n")
+		msg.WriteString(green("   --set 'spec.availabilityZones=[us-east-1a, us-east-1b]'\n"))
+		msg.WriteString(green("   --set spec.availabilityZones[+]=us-east-1c\n"))
+		msg.WriteString(green("   --set 'spec.container.resources={limits: {cpu: 1}}'\n"))
+		msg.WriteString(green("   --set spec.container.resources.limits.cpu=1\n"))
+	} else if strings.Contains(errMsg, "not found in message") {
 		msg.WriteString("   The field path you specified doesn't exist in the manifest.\n")
 		msg.WriteString("   Check the field name spelling and nesting level.\n")
+	} else if strings.Contains(errMsg, "out of range") || strings.Contains(errMsg, "is a list") || strings.Contains(errMsg, "is a map") {
+		msg.WriteString("   Select list elements with [index] or append with [+]; select map entries with\n")
+		msg.WriteString("   .key or [\"key\"]:\n\n")
+		msg.WriteString(green("   --set spec.subnets[0].cidr=10.0.1.0/24\n"))
+		msg.WriteString(green("   --set 'spec.labels[\"app.kubernetes.io/name\"]=web'\n"))
 	} else {
 		msg.WriteString("   Check your --set flag syntax and ensure the field path is correct.\n")
-		msg.WriteString("   Field paths must use dot notation (e.g., spec.container.app.image.repo).\n")
+		msg.WriteString("   Field paths use dot notation (e.g., spec.container.app.image.repo), [index]\n")
+		msg.WriteString("   for list elements and [\"key\"] for map keys. Set a field to null to clear it.\n")
 	}
 
 	msg.WriteString("\n")
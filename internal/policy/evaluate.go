@@ -0,0 +1,145 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// celEnv declares the top-level manifest fields rules can read.
+var celEnv = mustCelEnv()
+
+func mustCelEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("apiVersion", cel.StringType),
+		cel.Variable("kind", cel.StringType),
+		cel.Variable("metadata", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("spec", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("status", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create policy CEL environment: %v", err))
+	}
+	return env
+}
+
+// Violation is a policy a manifest does not comply with.
+type Violation struct {
+	Policy *Policy
+	// Message explains the violation; for rules that fail to evaluate it holds the error
+	Message string
+}
+
+// Blocking reports whether the violation must stop the manifest from being applied.
+func (v *Violation) Blocking() bool {
+	return v.Policy.Severity == SeverityError
+}
+
+func (v *Violation) String() string {
+	return fmt.Sprintf("[%s] %s: %s", v.Policy.Severity, v.Policy.Name, v.Message)
+}
+
+// HasBlocking reports whether any of the violations is blocking.
+func HasBlocking(violations []*Violation) bool {
+	for _, v := range violations {
+		if v.Blocking() {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate evaluates every policy selecting the manifest and returns the ones it violates.
+// A rule that fails to evaluate, e.g. because it reads a field of an unset message, is
+// reported as a violation since compliance cannot be shown.
+func (s *Set) Evaluate(manifest proto.Message) ([]*Violation, error) {
+	activation, err := newActivation(manifest)
+	if err != nil {
+		return nil, err
+	}
+	kindName, _ := crkreflect.ExtractKindFromProto(manifest)
+	metadata := metadatareflect.ExtractMetadata(manifest)
+
+	var violations []*Violation
+	for _, p := range s.Policies {
+		if !p.Match.selects(kindName, metadata.GetEnv(), metadata.GetLabels()) {
+			continue
+		}
+		out, _, err := p.program.Eval(activation)
+		if err != nil {
+			violations = append(violations, &Violation{Policy: p, Message: "rule could not be evaluated: " + err.Error()})
+			continue
+		}
+		ok, isBool := out.Value().(bool)
+		if !isBool {
+			violations = append(violations, &Violation{Policy: p, Message: fmt.Sprintf("rule evaluated to %v, not a bool", out.Value())})
+			continue
+		}
+		if !ok {
+			message := p.Message
+			if message == "" {
+				message = "rule is false: " + p.Rule
+			}
+			violations = append(violations, &Violation{Policy: p, Message: message})
+		}
+	}
+	return violations, nil
+}
+
+// newActivation converts the manifest to the JSON form users write, with unset fields
+// included so that rules can read them without has() checks.
+func newActivation(manifest proto.Message) (map[string]interface{}, error) {
+	jsonBytes, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal manifest to json")
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(jsonBytes, &fields); err != nil {
+		return nil, errors.Wrap(err, "failed to decode manifest json")
+	}
+	activation := map[string]interface{}{
+		"apiVersion": fmt.Sprint(emptyIfNil(fields["apiVersion"], "")),
+		"kind":       fmt.Sprint(emptyIfNil(fields["kind"], "")),
+		"metadata":   emptyIfNil(fields["metadata"], map[string]interface{}{}),
+		"spec":       emptyIfNil(fields["spec"], map[string]interface{}{}),
+		"status":     emptyIfNil(fields["status"], map[string]interface{}{}),
+	}
+	return activation, nil
+}
+
+func emptyIfNil(value, empty interface{}) interface{} {
+	if value == nil {
+		return empty
+	}
+	return value
+}
+
+func (m Match) selects(kindName, env string, labels map[string]string) bool {
+	if len(m.Kinds) > 0 && !contains(m.Kinds, kindName) {
+		return false
+	}
+	if len(m.Envs) > 0 && !contains(m.Envs, env) {
+		return false
+	}
+	for key, value := range m.Labels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
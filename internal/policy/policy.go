@@ -0,0 +1,170 @@
+// Package policy evaluates user-supplied CEL rules against manifests before they are handed
+// to a provisioner, e.g. "no public S3 buckets in prod" or "every resource needs an owner label".
+//
+// Policies are read from YAML files in a policy directory:
+//
+//	policies:
+//	  - name: rds-multi-az-in-prod
+//	    match:
+//	      kinds: [AwsRdsInstance]
+//	      envs: [prod]
+//	    rule: spec.multiAz
+//	    message: RDS instances in prod must be multi-AZ
+//
+// A rule is a CEL expression that must evaluate to true for a compliant manifest. It can read
+// the manifest's apiVersion, kind, metadata, spec and status using the camelCase field names
+// of the manifest YAML. Unset fields hold their zero value, and unset messages are null.
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/workspace"
+	"sigs.k8s.io/yaml"
+)
+
+// policyDirName is the directory under the CLI workspace (~/.openmcf) read when no policy
+// directory is given.
+const policyDirName = "policies"
+
+// Severity is how a violation of a policy is treated.
+type Severity string
+
+const (
+	// SeverityError violations block apply and plan.
+	SeverityError Severity = "error"
+	// SeverityWarning violations are reported but do not block.
+	SeverityWarning Severity = "warning"
+)
+
+// Policy is a single rule and the manifests it applies to.
+type Policy struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Match selects the manifests the rule is evaluated against; an empty match selects all
+	Match Match `json:"match,omitempty"`
+	// Rule is a CEL expression that is true for compliant manifests
+	Rule string `json:"rule"`
+	// Message is reported when the rule is false, defaulting to the rule itself
+	Message string `json:"message,omitempty"`
+	// Severity defaults to error
+	Severity Severity `json:"severity,omitempty"`
+
+	// Source is the file the policy was read from
+	Source  string `json:"-"`
+	program cel.Program
+}
+
+// Match selects manifests by kind, metadata.env and labels. Every non-empty criterion must match.
+type Match struct {
+	// Kinds are kind names such as AwsS3Bucket
+	Kinds []string `json:"kinds,omitempty"`
+	// Envs are metadata.env values
+	Envs []string `json:"envs,omitempty"`
+	// Labels must all be present on the manifest with the given values
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Set is a compiled collection of policies.
+type Set struct {
+	Policies []*Policy
+}
+
+type policyFile struct {
+	Policies []*Policy `json:"policies"`
+}
+
+// DefaultDir returns the policy directory used when none is configured, ~/.openmcf/policies.
+func DefaultDir() (string, error) {
+	workspaceDir, err := workspace.GetWorkspaceDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(workspaceDir, policyDirName), nil
+}
+
+// LoadDir reads and compiles the policies of every *.yaml/*.yml file in dir, in lexical order.
+func LoadDir(dir string) (*Set, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read policy directory %s", dir)
+	}
+	var files []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	set := &Set{}
+	names := map[string]string{}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", file)
+		}
+		policies, err := Parse(content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid policy file %s", file)
+		}
+		for _, p := range policies {
+			if other, ok := names[p.Name]; ok {
+				return nil, errors.Errorf("policy %q in %s is already defined in %s", p.Name, file, other)
+			}
+			names[p.Name] = file
+			p.Source = file
+		}
+		set.Policies = append(set.Policies, policies...)
+	}
+	return set, nil
+}
+
+// Parse reads and compiles the policies of a policy file.
+func Parse(content []byte) ([]*Policy, error) {
+	file := &policyFile{}
+	if err := yaml.UnmarshalStrict(content, file); err != nil {
+		return nil, errors.Wrap(err, "failed to parse policies")
+	}
+	for i, p := range file.Policies {
+		if p.Name == "" {
+			return nil, errors.Errorf("policy #%d has no name", i+1)
+		}
+		if err := p.compile(); err != nil {
+			return nil, errors.Wrapf(err, "policy %q", p.Name)
+		}
+	}
+	return file.Policies, nil
+}
+
+func (p *Policy) compile() error {
+	switch p.Severity {
+	case "":
+		p.Severity = SeverityError
+	case SeverityError, SeverityWarning:
+	default:
+		return errors.Errorf("unknown severity %q, expected %s or %s", p.Severity, SeverityError, SeverityWarning)
+	}
+	if strings.TrimSpace(p.Rule) == "" {
+		return errors.New("rule is required")
+	}
+	ast, issues := celEnv.Compile(p.Rule)
+	if issues != nil && issues.Err() != nil {
+		return errors.Wrap(issues.Err(), "failed to compile rule")
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return errors.Errorf("rule must evaluate to a bool, not %s", ast.OutputType())
+	}
+	program, err := celEnv.Program(ast)
+	if err != nil {
+		return errors.Wrap(err, "failed to build rule")
+	}
+	p.program = program
+	return nil
+}
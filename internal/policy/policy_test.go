@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicies = `
+policies:
+  - name: owner-label
+    rule: '"owner" in metadata.labels'
+    message: every resource needs an owner label
+  - name: nat-in-prod
+    match:
+      kinds: [AwsVpc]
+      envs: [prod]
+    rule: spec.isNatGatewayEnabled
+    message: prod VPCs need a NAT gateway
+  - name: small-subnets
+    match:
+      labels:
+        team: data
+    rule: spec.subnetSize <= 256
+    severity: warning
+`
+
+func testVpc(env string, labels map[string]string) *awsvpcv1.AwsVpc {
+	return &awsvpcv1.AwsVpc{
+		ApiVersion: "aws.openmcf.org/v1",
+		Kind:       "AwsVpc",
+		Metadata:   &shared.CloudResourceMetadata{Name: "main", Env: env, Labels: labels},
+		Spec:       &awsvpcv1.AwsVpcSpec{VpcCidr: "10.0.0.0/16", SubnetsPerAvailabilityZone: 1, SubnetSize: 1024},
+	}
+}
+
+func violationNames(violations []*Violation) []string {
+	var names []string
+	for _, v := range violations {
+		names = append(names, v.Policy.Name)
+	}
+	return names
+}
+
+func TestEvaluate(t *testing.T) {
+	policies, err := Parse([]byte(testPolicies))
+	require.NoError(t, err)
+	set := &Set{Policies: policies}
+
+	tests := []struct {
+		name         string
+		vpc          *awsvpcv1.AwsVpc
+		want         []string
+		wantBlocking bool
+	}{
+		{
+			name: "compliant",
+			vpc:  testVpc("dev", map[string]string{"owner": "net"}),
+		},
+		{
+			name:         "missing label",
+			vpc:          testVpc("dev", nil),
+			want:         []string{"owner-label"},
+			wantBlocking: true,
+		},
+		{
+			name:         "selected by env",
+			vpc:          testVpc("prod", map[string]string{"owner": "net"}),
+			want:         []string{"nat-in-prod"},
+			wantBlocking: true,
+		},
+		{
+			name: "warning only",
+			vpc:  testVpc("dev", map[string]string{"owner": "net", "team": "data"}),
+			want: []string{"small-subnets"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := set.Evaluate(tt.vpc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, violationNames(violations))
+			assert.Equal(t, tt.wantBlocking, HasBlocking(violations))
+		})
+	}
+}
+
+func TestEvaluate_RuleErrorIsViolation(t *testing.T) {
+	policies, err := Parse([]byte("policies:\n  - name: typo\n    rule: spec.noSuchField == 1\n"))
+	require.NoError(t, err)
+
+	violations, err := (&Set{Policies: policies}).Evaluate(testVpc("dev", nil))
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.True(t, violations[0].Blocking())
+	assert.Contains(t, violations[0].Message, "could not be evaluated")
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"missing name":     "policies:\n  - rule: 'true'\n",
+		"missing rule":     "policies:\n  - name: a\n",
+		"syntax error":     "policies:\n  - name: a\n    rule: 'spec.'\n",
+		"not a bool":       "policies:\n  - name: a\n    rule: '1 + 1'\n",
+		"unknown severity": "policies:\n  - name: a\n    rule: 'true'\n    severity: fatal\n",
+		"unknown field":    "policies:\n  - name: a\n    rule: 'true'\n    rules: 'true'\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(testPolicies), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o600))
+
+	set, err := LoadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, set.Policies, 3)
+	assert.Equal(t, filepath.Join(dir, "a.yaml"), set.Policies[0].Source)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte(testPolicies), 0o600))
+	_, err = LoadDir(dir)
+	assert.ErrorContains(t, err, "already defined")
+}
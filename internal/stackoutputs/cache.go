@@ -0,0 +1,100 @@
+package stackoutputs
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/cli/workspace"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+)
+
+// cacheDirName is the directory under the CLI workspace holding the last captured outputs
+// of every resource, one JSON file per resource at <Kind>/<env>/<name>.json.
+const cacheDirName = "outputs"
+
+// CacheEntry is the content of a cached outputs file.
+type CacheEntry struct {
+	ID         string                 `json:"id"`
+	CapturedAt time.Time              `json:"captured_at"`
+	Outputs    map[string]interface{} `json:"outputs"`
+	// State locates the state the outputs were read from, nil if it cannot be read without
+	// the resource's module (e.g. a local Tofu backend)
+	State *StateLocator `json:"state,omitempty"`
+}
+
+// StateLocator is where a resource's IaC state lives, so that its current outputs can be read
+// again without its manifest.
+type StateLocator struct {
+	// Provisioner is pulumi, tofu or terraform
+	Provisioner string `json:"provisioner"`
+	// PulumiStack is the fully qualified name of the Pulumi stack
+	PulumiStack string `json:"pulumi_stack,omitempty"`
+	// Backend is the Tofu/Terraform state backend
+	Backend *backendconfig.TofuBackendConfig `json:"backend,omitempty"`
+}
+
+// Save records the outputs of the resource identified by id (a stack set node ID such as
+// "GcpProject/prod/main") and the location of its state in the local cache.
+func Save(id string, outputs map[string]interface{}, state *StateLocator) error {
+	path, err := cachePath(id)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create %s", filepath.Dir(path))
+	}
+
+	entry := &CacheEntry{ID: id, CapturedAt: time.Now().UTC(), Outputs: outputs, State: state}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode outputs")
+	}
+	// Outputs can contain secrets, so the file is only readable by the current user
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
+}
+
+// Load returns the cached outputs of the resource identified by id, or nil if none were captured.
+func Load(id string) (*CacheEntry, error) {
+	path, err := cachePath(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	entry := &CacheEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+	return entry, nil
+}
+
+// Remove deletes the cached outputs of the resource identified by id, if any.
+func Remove(id string) error {
+	path, err := cachePath(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove %s", path)
+	}
+	return nil
+}
+
+func cachePath(id string) (string, error) {
+	workspaceDir, err := workspace.GetWorkspaceDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(workspaceDir, cacheDirName, filepath.FromSlash(id)+".json"), nil
+}
@@ -0,0 +1,36 @@
+package stackoutputs
+
+import (
+	"testing"
+
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	id := "AwsVpc/prod/main"
+
+	entry, err := Load(id)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+
+	state := &StateLocator{
+		Provisioner: "tofu",
+		Backend:     &backendconfig.TofuBackendConfig{BackendType: "s3", BackendBucket: "state", BackendKey: "vpc.tfstate"},
+	}
+	require.NoError(t, Save(id, map[string]interface{}{"vpc_id": "vpc-123"}, state))
+
+	entry, err = Load(id)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, id, entry.ID)
+	assert.Equal(t, map[string]interface{}{"vpc_id": "vpc-123"}, entry.Outputs)
+	assert.Equal(t, state, entry.State)
+
+	require.NoError(t, Remove(id))
+	entry, err = Load(id)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
@@ -0,0 +1,207 @@
+package stackoutputs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	statusFieldName  = "status"
+	outputsFieldName = "outputs"
+)
+
+// ToStackOutputs converts raw stack outputs into the typed <Kind>StackOutputs message of the
+// manifest's status. Outputs are matched to fields by proto name; outputs the message does not
+// model, or whose shape does not fit the field, are ignored.
+func ToStackOutputs(manifest proto.Message, outputs map[string]interface{}) (proto.Message, error) {
+	outputsField, err := outputsFieldOf(manifest)
+	if err != nil {
+		return nil, err
+	}
+	typed := manifest.ProtoReflect().NewField(outputsField.statusField).Message().NewField(outputsField.field).Message()
+	fill(typed, Unflatten(outputs))
+	return typed.Interface(), nil
+}
+
+// SetStatusOutputs returns a copy of manifest with status.outputs set to outputs.
+func SetStatusOutputs(manifest proto.Message, outputs proto.Message) (proto.Message, error) {
+	outputsField, err := outputsFieldOf(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if outputs.ProtoReflect().Descriptor() != outputsField.field.Message() {
+		return nil, errors.Errorf("outputs of type %s do not match %s",
+			outputs.ProtoReflect().Descriptor().FullName(), outputsField.field.Message().FullName())
+	}
+
+	updated := proto.Clone(manifest)
+	status := updated.ProtoReflect().Mutable(outputsField.statusField).Message()
+	status.Set(outputsField.field, protoreflect.ValueOfMessage(outputs.ProtoReflect()))
+	return updated, nil
+}
+
+type statusOutputsField struct {
+	statusField protoreflect.FieldDescriptor
+	field       protoreflect.FieldDescriptor
+}
+
+func outputsFieldOf(manifest proto.Message) (*statusOutputsField, error) {
+	descriptor := manifest.ProtoReflect().Descriptor()
+	statusField := descriptor.Fields().ByName(statusFieldName)
+	if statusField == nil || statusField.Message() == nil {
+		return nil, errors.Errorf("%s has no status message", descriptor.FullName())
+	}
+	field := statusField.Message().Fields().ByName(outputsFieldName)
+	if field == nil || field.Message() == nil || field.IsList() || field.IsMap() {
+		return nil, errors.Errorf("%s has no status.outputs message", descriptor.FullName())
+	}
+	return &statusOutputsField{statusField: statusField, field: field}, nil
+}
+
+// fill sets the fields of msg from data, skipping values that cannot be converted.
+func fill(msg protoreflect.Message, data map[string]interface{}) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		raw, ok := data[string(field.Name())]
+		if !ok {
+			raw, ok = data[field.JSONName()]
+		}
+		if !ok || raw == nil {
+			continue
+		}
+
+		switch {
+		case field.IsList():
+			items, ok := raw.([]interface{})
+			if !ok {
+				continue
+			}
+			list := msg.Mutable(field).List()
+			for _, item := range items {
+				if value, ok := toValue(field, item, list.NewElement); ok {
+					list.Append(value)
+				}
+			}
+			if list.Len() == 0 {
+				msg.Clear(field)
+			}
+		case field.IsMap():
+			entries, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			keys := make([]string, 0, len(entries))
+			for key := range entries {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			m := msg.Mutable(field).Map()
+			for _, key := range keys {
+				mapKey, ok := toScalar(field.MapKey(), key)
+				if !ok {
+					continue
+				}
+				if value, ok := toValue(field.MapValue(), entries[key], m.NewValue); ok {
+					m.Set(mapKey.MapKey(), value)
+				}
+			}
+			if m.Len() == 0 {
+				msg.Clear(field)
+			}
+		default:
+			if value, ok := toValue(field, raw, func() protoreflect.Value { return msg.NewField(field) }); ok {
+				msg.Set(field, value)
+			}
+		}
+	}
+}
+
+// toValue converts a single decoded JSON value to the kind of field. newMessage returns an empty
+// value to populate when the field is a message.
+func toValue(field protoreflect.FieldDescriptor, raw interface{}, newMessage func() protoreflect.Value) (protoreflect.Value, bool) {
+	if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+		return toScalar(field, raw)
+	}
+	data, ok := raw.(map[string]interface{})
+	if !ok {
+		return protoreflect.Value{}, false
+	}
+	value := newMessage()
+	fill(value.Message(), data)
+	return value, true
+}
+
+func toScalar(field protoreflect.FieldDescriptor, raw interface{}) (protoreflect.Value, bool) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		switch v := raw.(type) {
+		case string:
+			return protoreflect.ValueOfString(v), true
+		case float64, bool:
+			return protoreflect.ValueOfString(fmt.Sprint(v)), true
+		}
+	case protoreflect.BoolKind:
+		switch v := raw.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(v), true
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return protoreflect.ValueOfBool(b), true
+			}
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, ok := toNumber(raw); ok && n == math.Trunc(n) && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return protoreflect.ValueOfInt32(int32(n)), true
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := toNumber(raw); ok && n == math.Trunc(n) {
+			return protoreflect.ValueOfInt64(int64(n)), true
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, ok := toNumber(raw); ok && n == math.Trunc(n) && n >= 0 && n <= math.MaxUint32 {
+			return protoreflect.ValueOfUint32(uint32(n)), true
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, ok := toNumber(raw); ok && n == math.Trunc(n) && n >= 0 {
+			return protoreflect.ValueOfUint64(uint64(n)), true
+		}
+	case protoreflect.DoubleKind:
+		if n, ok := toNumber(raw); ok {
+			return protoreflect.ValueOfFloat64(n), true
+		}
+	case protoreflect.FloatKind:
+		if n, ok := toNumber(raw); ok {
+			return protoreflect.ValueOfFloat32(float32(n)), true
+		}
+	case protoreflect.EnumKind:
+		switch v := raw.(type) {
+		case string:
+			if enumValue := field.Enum().Values().ByName(protoreflect.Name(v)); enumValue != nil {
+				return protoreflect.ValueOfEnum(enumValue.Number()), true
+			}
+		case float64:
+			if enumValue := field.Enum().Values().ByNumber(protoreflect.EnumNumber(v)); enumValue != nil {
+				return protoreflect.ValueOfEnum(enumValue.Number()), true
+			}
+		}
+	}
+	return protoreflect.Value{}, false
+}
+
+func toNumber(raw interface{}) (float64, bool) {
+	switch v := raw.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
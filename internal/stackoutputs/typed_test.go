@@ -0,0 +1,43 @@
+package stackoutputs
+
+import (
+	"testing"
+
+	gcpgcsbucketv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp/gcpgcsbucket/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnflatten(t *testing.T) {
+	nested := Unflatten(map[string]interface{}{
+		"vpc_id":               "vpc-1",
+		"private_subnets.0.id": "subnet-a",
+		"private_subnets.1.id": "subnet-b",
+		"tags.team":            "platform",
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"vpc_id": "vpc-1",
+		"private_subnets": []interface{}{
+			map[string]interface{}{"id": "subnet-a"},
+			map[string]interface{}{"id": "subnet-b"},
+		},
+		"tags": map[string]interface{}{"team": "platform"},
+	}, nested)
+}
+
+func TestToStackOutputs(t *testing.T) {
+	bucket := &gcpgcsbucketv1.GcpGcsBucket{}
+
+	typed, err := ToStackOutputs(bucket, map[string]interface{}{
+		"bucket_id": "logs",
+		"unknown":   "ignored",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "logs", typed.(*gcpgcsbucketv1.GcpGcsBucketStackOutputs).BucketId)
+
+	updated, err := SetStatusOutputs(bucket, typed)
+	require.NoError(t, err)
+	assert.Equal(t, "logs", updated.(*gcpgcsbucketv1.GcpGcsBucket).GetStatus().GetOutputs().GetBucketId())
+	assert.Nil(t, bucket.Status)
+}
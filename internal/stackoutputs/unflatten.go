@@ -0,0 +1,80 @@
+package stackoutputs
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Unflatten turns flat output keys into nested values. Pulumi modules export keys such as
+// "private_subnets.0.id", which become {"private_subnets": [{"id": ...}]}. Maps whose keys are
+// all indexes become lists. Values that are already nested, as Tofu outputs are, pass through.
+func Unflatten(outputs map[string]interface{}) map[string]interface{} {
+	nested := map[string]interface{}{}
+
+	// Insert shorter keys first so a scalar output wins over a conflicting nested one
+	keys := make([]string, 0, len(outputs))
+	for key := range outputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		insert(nested, strings.Split(key, "."), outputs[key])
+	}
+	return listify(nested).(map[string]interface{})
+}
+
+func insert(node map[string]interface{}, segments []string, value interface{}) {
+	head := segments[0]
+	if len(segments) == 1 {
+		if _, exists := node[head]; !exists {
+			node[head] = value
+		}
+		return
+	}
+	child, exists := node[head]
+	if !exists {
+		child = map[string]interface{}{}
+		node[head] = child
+	}
+	if childMap, ok := child.(map[string]interface{}); ok {
+		insert(childMap, segments[1:], value)
+	}
+}
+
+func listify(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = listify(child)
+		}
+		if list, ok := asList(v); ok {
+			return list
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = listify(child)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// asList converts a map keyed by 0..n-1 into a list.
+func asList(m map[string]interface{}) ([]interface{}, bool) {
+	if len(m) == 0 {
+		return nil, false
+	}
+	list := make([]interface{}, len(m))
+	for key, value := range m {
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= len(m) {
+			return nil, false
+		}
+		list[index] = value
+	}
+	return list, true
+}
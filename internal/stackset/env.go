@@ -0,0 +1,29 @@
+package stackset
+
+import (
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest/envoverlay"
+)
+
+// ApplyEnvOverlay applies the env/<env>.yaml patches found next to path (the directory or
+// multi-document file the stack set was loaded from) to every manifest and sets their
+// metadata.env, so that dependencies are resolved within the selected environment.
+func (s *StackSet) ApplyEnvOverlay(path, env string) error {
+	overlay, err := envoverlay.Load(path, env)
+	if err != nil {
+		return err
+	}
+	for i, m := range s.Manifests {
+		patchedPath, err := overlay.ApplyToFile(m.Path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply env overlay to %s", m.Source)
+		}
+		s.tempFiles = append(s.tempFiles, patchedPath)
+		patched, err := loadManifest(patchedPath, m.Source)
+		if err != nil {
+			return err
+		}
+		s.Manifests[i] = patched
+	}
+	return overlay.CheckMatched()
+}
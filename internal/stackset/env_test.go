@@ -0,0 +1,53 @@
+package stackset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyEnvOverlay(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "all.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(`apiVersion: aws.openmcf.org/v1
+kind: AwsVpc
+metadata:
+  name: main
+spec:
+  vpcCidr: 10.0.0.0/16
+---
+apiVersion: aws.openmcf.org/v1
+kind: AwsVpc
+metadata:
+  name: edge
+spec:
+  vpcCidr: 10.1.0.0/16
+`), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "env"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "env", "prod.yaml"), []byte(`spec.subnetSize: 512
+---
+metadata.name: edge
+spec.isNatGatewayEnabled: true
+`), 0o600))
+
+	set, err := Load(manifestPath)
+	require.NoError(t, err)
+	defer set.Cleanup()
+	require.NoError(t, set.ApplyEnvOverlay(manifestPath, "prod"))
+
+	require.Len(t, set.Manifests, 2)
+	for _, m := range set.Manifests {
+		vpc := m.Object.(*awsvpcv1.AwsVpc)
+		assert.Equal(t, "prod", m.Metadata.Env)
+		assert.Equal(t, int32(512), vpc.Spec.SubnetSize)
+		assert.Equal(t, m.Metadata.Name == "edge", vpc.Spec.IsNatGatewayEnabled, m.Source)
+	}
+
+	graph, err := BuildGraph(set.Manifests)
+	require.NoError(t, err)
+	assert.NotNil(t, graph.Node(NodeID("AwsVpc", "prod", "edge")))
+}
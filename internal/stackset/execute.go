@@ -0,0 +1,146 @@
+package stackset
+
+import (
+	"sync"
+)
+
+// Status is the outcome of running an operation against a single node.
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	// StatusSkipped marks nodes that were not run because a node they wait on failed or was skipped.
+	StatusSkipped Status = "skipped"
+)
+
+// NodeResult is the outcome for a single node.
+type NodeResult struct {
+	Node   *Node
+	Status Status
+	Err    error
+}
+
+// ExecuteOptions controls how a graph is walked.
+type ExecuteOptions struct {
+	// Concurrency is the maximum number of nodes run at the same time. Values below 1 are treated as 1.
+	Concurrency int
+	// Reverse walks the graph dependents-first, as needed for destroy.
+	Reverse bool
+}
+
+// Execute runs fn for every node once all nodes it waits on have succeeded, running independent
+// branches in parallel up to the concurrency limit. When a node fails, every node waiting on it
+// (directly or transitively) is skipped while unrelated branches keep going.
+// Results are returned in completion order.
+func (g *Graph) Execute(opts ExecuteOptions, fn func(*Node) error) []*NodeResult {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	waitsOn := func(n *Node) []*Node {
+		if opts.Reverse {
+			return n.Dependents
+		}
+		return n.DependsOn
+	}
+	unblocks := func(n *Node) []*Node {
+		if opts.Reverse {
+			return n.DependsOn
+		}
+		return n.Dependents
+	}
+
+	// Visit nodes in (reverse) topological order so ties are broken deterministically.
+	order := make([]*Node, len(g.Nodes))
+	copy(order, g.Nodes)
+	if opts.Reverse {
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+
+	pending := map[*Node]int{}
+	for _, n := range order {
+		pending[n] = len(waitsOn(n))
+	}
+
+	var (
+		results  []*NodeResult
+		done     = map[*Node]Status{}
+		ready    []*Node
+		running  int
+		finished = make(chan *NodeResult)
+		wg       sync.WaitGroup
+	)
+
+	for _, n := range order {
+		if pending[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+
+	// skip marks n and everything it unblocks as skipped.
+	var skip func(n *Node)
+	skip = func(n *Node) {
+		if _, ok := done[n]; ok {
+			return
+		}
+		done[n] = StatusSkipped
+		results = append(results, &NodeResult{Node: n, Status: StatusSkipped})
+		for _, next := range unblocks(n) {
+			skip(next)
+		}
+	}
+
+	for len(done) < len(order) {
+		for running < concurrency && len(ready) > 0 {
+			n := ready[0]
+			ready = ready[1:]
+			if _, ok := done[n]; ok {
+				continue
+			}
+			running++
+			wg.Add(1)
+			go func(n *Node) {
+				defer wg.Done()
+				err := fn(n)
+				status := StatusSucceeded
+				if err != nil {
+					status = StatusFailed
+				}
+				finished <- &NodeResult{Node: n, Status: status, Err: err}
+			}(n)
+		}
+
+		if running == 0 {
+			// Nothing runnable and nothing in flight: remaining nodes are blocked by skipped ones.
+			for _, n := range order {
+				if _, ok := done[n]; !ok {
+					skip(n)
+				}
+			}
+			break
+		}
+
+		result := <-finished
+		running--
+		done[result.Node] = result.Status
+		results = append(results, result)
+
+		for _, next := range unblocks(result.Node) {
+			if result.Status != StatusSucceeded {
+				skip(next)
+				continue
+			}
+			pending[next]--
+			if pending[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	wg.Wait()
+	return results
+}
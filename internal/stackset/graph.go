@@ -0,0 +1,149 @@
+package stackset
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	relationshipv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/relationship/v1"
+	"github.com/plantonhq/openmcf/internal/valuefrom"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+)
+
+// Node is a manifest in the dependency graph.
+type Node struct {
+	// ID uniquely identifies the resource within the stack set: <Kind>/<env>/<name>, or <Kind>/<name> without env.
+	ID       string
+	Manifest *Manifest
+	// DependsOn lists the nodes that must be applied before this one.
+	DependsOn []*Node
+	// Dependents lists the nodes that must be applied after this one.
+	Dependents []*Node
+}
+
+// Graph is the dependency graph of a stack set.
+type Graph struct {
+	// Nodes are in topological order: every node appears after all nodes it depends on.
+	Nodes []*Node
+	byID  map[string]*Node
+}
+
+// NodeID builds the identifier used to match resources and references within a stack set.
+func NodeID(kindName, env, name string) string {
+	if env == "" {
+		return fmt.Sprintf("%s/%s", kindName, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", kindName, env, name)
+}
+
+// Node returns the node with the given ID, or nil.
+func (g *Graph) Node(id string) *Node {
+	return g.byID[id]
+}
+
+// BuildGraph builds the dependency graph between manifests. Edges come from
+// metadata.relationships of type depends_on/runs_on and from value_from references in the spec.
+// References to resources that are not part of the set are ignored: they are assumed to exist already.
+// An empty env on a relationship or reference resolves to the env of the referencing manifest.
+func BuildGraph(manifests []*Manifest) (*Graph, error) {
+	g := &Graph{byID: map[string]*Node{}}
+	var nodes []*Node
+	for _, m := range manifests {
+		node := &Node{
+			ID:       NodeID(m.KindName, m.Metadata.Env, m.Metadata.Name),
+			Manifest: m,
+		}
+		if existing, ok := g.byID[node.ID]; ok {
+			return nil, errors.Errorf("duplicate resource %s in %s and %s", node.ID, existing.Manifest.Source, m.Source)
+		}
+		g.byID[node.ID] = node
+		nodes = append(nodes, node)
+	}
+
+	for _, node := range nodes {
+		seen := map[string]bool{}
+		for _, depID := range dependencyIDs(node.Manifest) {
+			dep, ok := g.byID[depID]
+			if !ok || dep == node || seen[depID] {
+				continue
+			}
+			seen[depID] = true
+			node.DependsOn = append(node.DependsOn, dep)
+			dep.Dependents = append(dep.Dependents, node)
+		}
+	}
+
+	ordered, err := topologicalSort(nodes)
+	if err != nil {
+		return nil, err
+	}
+	g.Nodes = ordered
+	return g, nil
+}
+
+// dependencyIDs returns the IDs of every resource the manifest points at.
+func dependencyIDs(m *Manifest) []string {
+	env := m.Metadata.Env
+	var ids []string
+
+	for _, rel := range m.Metadata.Relationships {
+		if rel.Type != relationshipv1.CloudResourceRelationship_depends_on &&
+			rel.Type != relationshipv1.CloudResourceRelationship_runs_on {
+			continue
+		}
+		ids = append(ids, refID(rel.Kind, rel.Env, rel.Name, env))
+	}
+
+	for _, ref := range valuefrom.FindRefs(m.Object) {
+		kind := ref.Kind()
+		if kind == cloudresourcekind.CloudResourceKind_unspecified || ref.ValueFrom.GetName() == "" {
+			continue
+		}
+		ids = append(ids, refID(kind, ref.ValueFrom.GetEnv(), ref.ValueFrom.GetName(), env))
+	}
+	return ids
+}
+
+func refID(kind cloudresourcekind.CloudResourceKind, env, name, defaultEnv string) string {
+	if env == "" {
+		env = defaultEnv
+	}
+	return NodeID(crkreflect.ExtractKindNameByKind(kind), env, name)
+}
+
+// topologicalSort orders nodes with Kahn's algorithm, keeping the input order among independent nodes.
+func topologicalSort(nodes []*Node) ([]*Node, error) {
+	remaining := map[*Node]int{}
+	for _, n := range nodes {
+		remaining[n] = len(n.DependsOn)
+	}
+
+	var ordered []*Node
+	for len(ordered) < len(nodes) {
+		progressed := false
+		for _, n := range nodes {
+			if remaining[n] != 0 {
+				continue
+			}
+			remaining[n] = -1
+			ordered = append(ordered, n)
+			for _, d := range n.Dependents {
+				remaining[d]--
+			}
+			progressed = true
+		}
+		if !progressed {
+			var cyclic []string
+			for _, n := range nodes {
+				if remaining[n] > 0 {
+					cyclic = append(cyclic, n.ID)
+				}
+			}
+			sort.Strings(cyclic)
+			return nil, errors.Errorf("dependency cycle detected between: %s", strings.Join(cyclic, ", "))
+		}
+	}
+	return ordered, nil
+}
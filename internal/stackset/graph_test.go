@@ -0,0 +1,154 @@
+package stackset
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	awsvpcv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/aws/awsvpc/v1"
+	gcpgcsbucketv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp/gcpgcsbucket/v1"
+	gcpprojectv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp/gcpproject/v1"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	foreignkeyv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/foreignkey/v1"
+	relationshipv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/relationship/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func projectManifest(name, env string) *Manifest {
+	md := &shared.CloudResourceMetadata{Name: name, Env: env}
+	return &Manifest{
+		Source:   name,
+		KindName: "GcpProject",
+		Metadata: md,
+		Object:   &gcpprojectv1.GcpProject{Kind: "GcpProject", Metadata: md},
+	}
+}
+
+func bucketManifest(name, env, projectName string) *Manifest {
+	md := &shared.CloudResourceMetadata{Name: name, Env: env}
+	return &Manifest{
+		Source:   name,
+		KindName: "GcpGcsBucket",
+		Metadata: md,
+		Object: &gcpgcsbucketv1.GcpGcsBucket{
+			Kind:     "GcpGcsBucket",
+			Metadata: md,
+			Spec: &gcpgcsbucketv1.GcpGcsBucketSpec{
+				// kind is omitted so the default_kind field option is used
+				GcpProjectId: &foreignkeyv1.StringValueOrRef{
+					LiteralOrRef: &foreignkeyv1.StringValueOrRef_ValueFrom{
+						ValueFrom: &foreignkeyv1.ValueFromRef{Name: projectName},
+					},
+				},
+			},
+		},
+	}
+}
+
+func vpcManifest(name, env string, relationships ...*relationshipv1.CloudResourceRelationship) *Manifest {
+	md := &shared.CloudResourceMetadata{Name: name, Env: env, Relationships: relationships}
+	return &Manifest{
+		Source:   name,
+		KindName: "AwsVpc",
+		Metadata: md,
+		Object:   &awsvpcv1.AwsVpc{Kind: "AwsVpc", Metadata: md},
+	}
+}
+
+func ids(nodes []*Node) []string {
+	var out []string
+	for _, n := range nodes {
+		out = append(out, n.ID)
+	}
+	return out
+}
+
+func TestBuildGraph_ValueFromAndRelationships(t *testing.T) {
+	bucket := bucketManifest("assets", "prod", "main")
+	project := projectManifest("main", "prod")
+	vpc := vpcManifest("net", "prod", &relationshipv1.CloudResourceRelationship{
+		Kind: cloudresourcekind.CloudResourceKind_GcpGcsBucket,
+		Name: "assets",
+		Type: relationshipv1.CloudResourceRelationship_depends_on,
+	})
+
+	g, err := BuildGraph([]*Manifest{vpc, bucket, project})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GcpProject/prod/main", "GcpGcsBucket/prod/assets", "AwsVpc/prod/net"}, ids(g.Nodes))
+	assert.Equal(t, []string{"GcpProject/prod/main"}, ids(g.Node("GcpGcsBucket/prod/assets").DependsOn))
+}
+
+func TestBuildGraph_IgnoresExternalAndOtherEnvReferences(t *testing.T) {
+	g, err := BuildGraph([]*Manifest{
+		bucketManifest("assets", "dev", "main"),
+		projectManifest("main", "prod"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, g.Node("GcpGcsBucket/dev/assets").DependsOn)
+}
+
+func TestBuildGraph_Cycle(t *testing.T) {
+	a := vpcManifest("a", "", &relationshipv1.CloudResourceRelationship{
+		Kind: cloudresourcekind.CloudResourceKind_AwsVpc, Name: "b", Type: relationshipv1.CloudResourceRelationship_depends_on,
+	})
+	b := vpcManifest("b", "", &relationshipv1.CloudResourceRelationship{
+		Kind: cloudresourcekind.CloudResourceKind_AwsVpc, Name: "a", Type: relationshipv1.CloudResourceRelationship_runs_on,
+	})
+	_, err := BuildGraph([]*Manifest{a, b})
+	assert.ErrorContains(t, err, "dependency cycle detected between: AwsVpc/a, AwsVpc/b")
+}
+
+func TestBuildGraph_Duplicate(t *testing.T) {
+	_, err := BuildGraph([]*Manifest{vpcManifest("a", "dev"), vpcManifest("a", "dev")})
+	assert.ErrorContains(t, err, "duplicate resource AwsVpc/dev/a")
+}
+
+func TestExecute_OrderAndFailurePropagation(t *testing.T) {
+	project := projectManifest("main", "")
+	bucket := bucketManifest("assets", "", "main")
+	other := vpcManifest("net", "")
+	g, err := BuildGraph([]*Manifest{bucket, project, other})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var ran []string
+	results := g.Execute(ExecuteOptions{Concurrency: 2}, func(n *Node) error {
+		mu.Lock()
+		ran = append(ran, n.ID)
+		mu.Unlock()
+		if n.ID == "GcpProject/main" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	statuses := map[string]Status{}
+	for _, r := range results {
+		statuses[r.Node.ID] = r.Status
+	}
+	assert.Equal(t, StatusFailed, statuses["GcpProject/main"])
+	assert.Equal(t, StatusSkipped, statuses["GcpGcsBucket/assets"])
+	assert.Equal(t, StatusSucceeded, statuses["AwsVpc/net"])
+	assert.NotContains(t, ran, "GcpGcsBucket/assets")
+}
+
+func TestExecute_Reverse(t *testing.T) {
+	g, err := BuildGraph([]*Manifest{bucketManifest("assets", "", "main"), projectManifest("main", "")})
+	require.NoError(t, err)
+
+	var ran []string
+	g.Execute(ExecuteOptions{Concurrency: 4, Reverse: true}, func(n *Node) error {
+		ran = append(ran, n.ID)
+		return nil
+	})
+	assert.Equal(t, []string{"GcpGcsBucket/assets", "GcpProject/main"}, ran)
+}
+
+func TestSplitDocuments(t *testing.T) {
+	docs, err := SplitDocuments([]byte("---\n# leading comment\n---\nkind: A\n---\nkind: B\n...\n---\n\n"))
+	require.NoError(t, err)
+	assert.Len(t, docs, 2)
+}
@@ -0,0 +1,239 @@
+package stackset
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/crkreflect"
+	"github.com/plantonhq/openmcf/pkg/reflection/metadatareflect"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// Manifest is a single manifest loaded as part of a stack set.
+type Manifest struct {
+	// Path is the manifest file to hand to the provisioner. For documents split out of a
+	// multi-document file this is a temporary file.
+	Path string
+	// Source describes where the manifest came from, e.g. "infra/vpc.yaml" or "infra/all.yaml#2".
+	Source string
+	// KindName is the value of the manifest's kind field, e.g. "AwsVpc".
+	KindName string
+	// Metadata is the manifest's metadata.
+	Metadata *shared.CloudResourceMetadata
+	// Object is the loaded manifest proto with defaults applied.
+	Object proto.Message
+}
+
+// StackSet is a collection of manifests loaded from a directory or a multi-document YAML file.
+type StackSet struct {
+	Manifests []*Manifest
+	tempFiles []string
+}
+
+// Cleanup removes any temporary files created while splitting multi-document input.
+func (s *StackSet) Cleanup() {
+	for _, f := range s.tempFiles {
+		_ = os.Remove(f)
+	}
+}
+
+// IsStackSet reports whether path refers to more than one manifest, i.e. it is a directory
+// or a YAML file containing multiple documents.
+func IsStackSet(path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		// Not a local path (e.g. a URL); leave it to the single-manifest loader.
+		return false, nil
+	}
+	if info.IsDir() {
+		return true, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to read %s", path)
+	}
+	docs, err := SplitDocuments(content)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to split %s into documents", path)
+	}
+	return len(docs) > 1, nil
+}
+
+// Load reads every manifest from path, which can be a directory of *.yaml/*.yml files
+// or a multi-document YAML file. Directory entries are read in lexical order and are not recursed into.
+func Load(path string) (*StackSet, error) {
+	files, err := manifestFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &StackSet{}
+	for _, file := range files {
+		if err := set.loadFile(file); err != nil {
+			set.Cleanup()
+			return nil, err
+		}
+	}
+	if len(set.Manifests) == 0 {
+		return nil, errors.Errorf("no manifests found in %s", path)
+	}
+	return set, nil
+}
+
+func (s *StackSet) loadFile(file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", file)
+	}
+	docs, err := SplitDocuments(content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to split %s into documents", file)
+	}
+
+	for i, doc := range docs {
+		manifestPath := file
+		source := file
+		if len(docs) > 1 {
+			source = fmt.Sprintf("%s#%d", file, i+1)
+			manifestPath, err = s.writeTempDocument(doc)
+			if err != nil {
+				return err
+			}
+		}
+
+		m, err := loadManifest(manifestPath, source)
+		if err != nil {
+			return err
+		}
+		s.Manifests = append(s.Manifests, m)
+	}
+	return nil
+}
+
+func (s *StackSet) writeTempDocument(doc []byte) (string, error) {
+	tempFile, err := os.CreateTemp("", "stackset-manifest-*.yaml")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temp file for manifest document")
+	}
+	defer tempFile.Close()
+	s.tempFiles = append(s.tempFiles, tempFile.Name())
+
+	if _, err := tempFile.Write(doc); err != nil {
+		return "", errors.Wrap(err, "failed to write manifest document")
+	}
+	return tempFile.Name(), nil
+}
+
+func loadManifest(manifestPath, source string) (*Manifest, error) {
+	object, err := manifest.LoadManifest(manifestPath)
+	if err != nil {
+		if manifest.IsManifestLoadError(err) {
+			return nil, &manifest.ManifestLoadError{ManifestPath: source, Err: err.(*manifest.ManifestLoadError).Err}
+		}
+		return nil, errors.Wrapf(err, "failed to load manifest %s", source)
+	}
+	kindName, err := crkreflect.ExtractKindFromProto(object)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract kind from %s", source)
+	}
+	metadata := metadatareflect.ExtractMetadata(object)
+	if metadata == nil || metadata.Name == "" {
+		return nil, errors.Errorf("manifest %s is missing metadata.name", source)
+	}
+	return &Manifest{
+		Path:     manifestPath,
+		Source:   source,
+		KindName: kindName,
+		Metadata: metadata,
+		Object:   object,
+	}, nil
+}
+
+// manifestFiles returns the YAML files making up the stack set at path.
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat %s", path)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %s", path)
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext == ".yaml" || ext == ".yml" {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// SplitDocuments splits a YAML stream on "---" separator lines and drops documents
+// that contain nothing but comments or whitespace.
+func SplitDocuments(content []byte) ([][]byte, error) {
+	var docs [][]byte
+	var current bytes.Buffer
+
+	flush := func() error {
+		doc := current.Bytes()
+		current = bytes.Buffer{}
+		empty, err := isEmptyDocument(doc)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			docs = append(docs, append([]byte(nil), doc...))
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimRight(line, " \t") == "---" || strings.HasPrefix(line, "--- ") {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		current.WriteString(line)
+		current.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func isEmptyDocument(doc []byte) (bool, error) {
+	if len(bytes.TrimSpace(doc)) == 0 {
+		return true, nil
+	}
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal(doc, &parsed); err != nil {
+		return false, err
+	}
+	return len(parsed) == 0, nil
+}
@@ -0,0 +1,44 @@
+package valuefrom
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ValueAtPath returns the value found at the dotted path in data, which is decoded JSON
+// (nested map[string]interface{} and []interface{} values). List elements are addressed by index.
+//
+// Keys may themselves contain dots, as Pulumi exports flat keys such as "private_subnets.0.id",
+// so at every level the longest key matching the remaining path wins.
+func ValueAtPath(data interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return data, true
+	}
+	return valueAtSegments(data, strings.Split(path, "."))
+}
+
+func valueAtSegments(data interface{}, segments []string) (interface{}, bool) {
+	if len(segments) == 0 {
+		return data, true
+	}
+
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for n := len(segments); n > 0; n-- {
+			child, ok := v[strings.Join(segments[:n], ".")]
+			if !ok {
+				continue
+			}
+			if value, found := valueAtSegments(child, segments[n:]); found {
+				return value, true
+			}
+		}
+	case []interface{}:
+		index, err := strconv.Atoi(segments[0])
+		if err != nil || index < 0 || index >= len(v) {
+			return nil, false
+		}
+		return valueAtSegments(v[index], segments[1:])
+	}
+	return nil, false
+}
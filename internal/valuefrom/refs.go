@@ -0,0 +1,118 @@
+package valuefrom
+
+import (
+	"fmt"
+
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	foreignkeyv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/foreignkey/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// valueFromRefFullName is the fully-qualified proto name of ValueFromRef.
+const valueFromRefFullName = "org.openmcf.shared.foreignkey.v1.ValueFromRef"
+
+// Ref is a value_from reference found while walking a manifest.
+type Ref struct {
+	// FieldPath is the dotted path of the StringValueOrRef/Int32ValueOrRef field holding the reference,
+	// e.g. "spec.gcp_project_id" or "spec.subnets[0].vpc_id".
+	FieldPath string
+	// ValueFrom is the reference as written in the manifest.
+	ValueFrom *foreignkeyv1.ValueFromRef
+	// DefaultKind is the kind declared with the default_kind field option on the holding field.
+	DefaultKind cloudresourcekind.CloudResourceKind
+	// DefaultKindFieldPath is the field path declared with the default_kind_field_path field option.
+	DefaultKindFieldPath string
+	// Holder is the StringValueOrRef/Int32ValueOrRef message that carries the reference.
+	Holder protoreflect.Message
+}
+
+// Kind returns the referenced kind, falling back to the default_kind field option.
+func (r *Ref) Kind() cloudresourcekind.CloudResourceKind {
+	if r.ValueFrom.GetKind() != cloudresourcekind.CloudResourceKind_unspecified {
+		return r.ValueFrom.GetKind()
+	}
+	return r.DefaultKind
+}
+
+// OutputFieldPath returns the referenced field path, falling back to the default_kind_field_path field option.
+func (r *Ref) OutputFieldPath() string {
+	if r.ValueFrom.GetFieldPath() != "" {
+		return r.ValueFrom.GetFieldPath()
+	}
+	return r.DefaultKindFieldPath
+}
+
+// FindRefs walks the populated fields of msg and returns every value_from reference it contains,
+// in field declaration order.
+func FindRefs(msg proto.Message) []*Ref {
+	var refs []*Ref
+	walk(msg.ProtoReflect(), "", nil, &refs)
+	return refs
+}
+
+func walk(m protoreflect.Message, path string, holderField protoreflect.FieldDescriptor, refs *[]*Ref) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Message() == nil || !m.Has(fd) {
+			continue
+		}
+		fieldPath := joinPath(path, string(fd.Name()))
+
+		if fd.Message().FullName() == valueFromRefFullName && !fd.IsList() && !fd.IsMap() {
+			*refs = append(*refs, newRef(m, path, holderField, m.Get(fd).Message()))
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				walk(list.Get(j).Message(), fmt.Sprintf("%s[%d]", fieldPath, j), fd, refs)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				continue
+			}
+			m.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				walk(v.Message(), fmt.Sprintf("%s[%s]", fieldPath, k.String()), fd, refs)
+				return true
+			})
+		default:
+			walk(m.Get(fd).Message(), fieldPath, fd, refs)
+		}
+	}
+}
+
+func newRef(holder protoreflect.Message, holderPath string, holderField protoreflect.FieldDescriptor,
+	valueFrom protoreflect.Message) *Ref {
+	ref := &Ref{
+		FieldPath: holderPath,
+		Holder:    holder,
+	}
+	if v, ok := valueFrom.Interface().(*foreignkeyv1.ValueFromRef); ok {
+		ref.ValueFrom = v
+	} else {
+		ref.ValueFrom = &foreignkeyv1.ValueFromRef{}
+		b, _ := proto.Marshal(valueFrom.Interface())
+		_ = proto.Unmarshal(b, ref.ValueFrom)
+	}
+	if holderField != nil && holderField.Options() != nil {
+		opts := holderField.Options()
+		if proto.HasExtension(opts, foreignkeyv1.E_DefaultKind) {
+			ref.DefaultKind = proto.GetExtension(opts, foreignkeyv1.E_DefaultKind).(cloudresourcekind.CloudResourceKind)
+		}
+		if proto.HasExtension(opts, foreignkeyv1.E_DefaultKindFieldPath) {
+			ref.DefaultKindFieldPath = proto.GetExtension(opts, foreignkeyv1.E_DefaultKindFieldPath).(string)
+		}
+	}
+	return ref
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
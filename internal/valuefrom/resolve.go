@@ -0,0 +1,143 @@
+package valuefrom
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Target identifies the resource a value_from reference points at.
+type Target struct {
+	Kind cloudresourcekind.CloudResourceKind
+	Env  string
+	Name string
+}
+
+// Source looks up values of referenced resources.
+type Source interface {
+	// Lookup returns the value found at fieldPath (e.g. "status.outputs.vpc_id" or "spec.name")
+	// of the target resource.
+	Lookup(target Target, fieldPath string) (interface{}, error)
+}
+
+// Resolve replaces every value_from reference in msg with the literal value looked up from source.
+// References without an env resolve against defaultEnv, the env of the manifest being resolved.
+// The kind and field path fall back to the default_kind and default_kind_field_path field options.
+func Resolve(msg proto.Message, defaultEnv string, source Source) error {
+	for _, ref := range FindRefs(msg) {
+		if err := resolveRef(ref, defaultEnv, source); err != nil {
+			return errors.Wrapf(err, "failed to resolve value_from for %s", ref.FieldPath)
+		}
+	}
+	return nil
+}
+
+func resolveRef(ref *Ref, defaultEnv string, source Source) error {
+	target := Target{
+		Kind: ref.Kind(),
+		Env:  ref.ValueFrom.GetEnv(),
+		Name: ref.ValueFrom.GetName(),
+	}
+	if target.Kind == cloudresourcekind.CloudResourceKind_unspecified {
+		return errors.New("kind is not set and the field declares no default kind")
+	}
+	if target.Name == "" {
+		return errors.New("name is not set")
+	}
+	if target.Env == "" {
+		target.Env = defaultEnv
+	}
+	fieldPath := ref.OutputFieldPath()
+	if fieldPath == "" {
+		return errors.New("field_path is not set and the field declares no default field path")
+	}
+
+	value, err := source.Lookup(target, fieldPath)
+	if err != nil {
+		return err
+	}
+	return setLiteral(ref, value)
+}
+
+// setLiteral sets the "value" member of the StringValueOrRef/Int32ValueOrRef holding ref,
+// which also clears value_from since both are members of the same oneof.
+func setLiteral(ref *Ref, value interface{}) error {
+	valueField := ref.Holder.Descriptor().Fields().ByName("value")
+	if valueField == nil {
+		return errors.Errorf("%s has no literal value field", ref.Holder.Descriptor().FullName())
+	}
+
+	switch valueField.Kind() {
+	case protoreflect.StringKind:
+		s, err := toString(value)
+		if err != nil {
+			return err
+		}
+		ref.Holder.Set(valueField, protoreflect.ValueOfString(s))
+	case protoreflect.Int32Kind:
+		i, err := toInt32(value)
+		if err != nil {
+			return err
+		}
+		ref.Holder.Set(valueField, protoreflect.ValueOfInt32(i))
+	default:
+		return errors.Errorf("unsupported literal value type %s", valueField.Kind())
+	}
+	return nil
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		if v == math.Trunc(v) {
+			return strconv.FormatInt(int64(v), 10), nil
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int, int32, int64:
+		return fmt.Sprintf("%d", v), nil
+	case nil:
+		return "", errors.New("referenced value is empty")
+	default:
+		return "", errors.Errorf("referenced value is a %T, not a scalar", value)
+	}
+}
+
+func toInt32(value interface{}) (int32, error) {
+	var i int64
+	switch v := value.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, errors.Errorf("referenced value %v is not an integer", v)
+		}
+		i = int64(v)
+	case int:
+		i = int64(v)
+	case int32:
+		i = int64(v)
+	case int64:
+		i = v
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0, errors.Errorf("referenced value %q is not an integer", v)
+		}
+		i = parsed
+	case nil:
+		return 0, errors.New("referenced value is empty")
+	default:
+		return 0, errors.Errorf("referenced value is a %T, not an integer", value)
+	}
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return 0, errors.Errorf("referenced value %d does not fit in int32", i)
+	}
+	return int32(i), nil
+}
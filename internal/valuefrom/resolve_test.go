@@ -0,0 +1,74 @@
+package valuefrom
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	gcpgcsbucketv1 "github.com/plantonhq/openmcf/apis/org/openmcf/provider/gcp/gcpgcsbucket/v1"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/cloudresourcekind"
+	foreignkeyv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/foreignkey/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapSource map[Target]map[string]interface{}
+
+func (s mapSource) Lookup(target Target, fieldPath string) (interface{}, error) {
+	value, found := ValueAtPath(s[target], fieldPath)
+	if !found {
+		return nil, errors.Errorf("no value at %s", fieldPath)
+	}
+	return value, nil
+}
+
+func bucketWithProjectRef(ref *foreignkeyv1.ValueFromRef) *gcpgcsbucketv1.GcpGcsBucket {
+	return &gcpgcsbucketv1.GcpGcsBucket{
+		Spec: &gcpgcsbucketv1.GcpGcsBucketSpec{
+			GcpProjectId: &foreignkeyv1.StringValueOrRef{
+				LiteralOrRef: &foreignkeyv1.StringValueOrRef_ValueFrom{ValueFrom: ref},
+			},
+		},
+	}
+}
+
+func TestResolve_UsesDefaultKindAndFieldPath(t *testing.T) {
+	bucket := bucketWithProjectRef(&foreignkeyv1.ValueFromRef{Name: "main"})
+	source := mapSource{
+		{Kind: cloudresourcekind.CloudResourceKind_GcpProject, Env: "prod", Name: "main"}: {
+			"status": map[string]interface{}{
+				"outputs": map[string]interface{}{"project_id": "main-1234"},
+			},
+		},
+	}
+
+	require.NoError(t, Resolve(bucket, "prod", source))
+	assert.Equal(t, "main-1234", bucket.Spec.GcpProjectId.GetValue())
+	assert.Nil(t, bucket.Spec.GcpProjectId.GetValueFrom())
+}
+
+func TestResolve_UnresolvableNamesFieldPath(t *testing.T) {
+	bucket := bucketWithProjectRef(&foreignkeyv1.ValueFromRef{Name: "missing"})
+
+	err := Resolve(bucket, "prod", mapSource{})
+	assert.ErrorContains(t, err, "failed to resolve value_from for spec.gcp_project_id")
+}
+
+func TestValueAtPath(t *testing.T) {
+	data := map[string]interface{}{
+		// Pulumi exports flat keys
+		"private_subnets.0.id": "subnet-a",
+		// Tofu outputs are nested values
+		"nodes": []interface{}{map[string]interface{}{"id": float64(7)}},
+	}
+
+	value, found := ValueAtPath(data, "private_subnets.0.id")
+	assert.True(t, found)
+	assert.Equal(t, "subnet-a", value)
+
+	value, found = ValueAtPath(data, "nodes.0.id")
+	assert.True(t, found)
+	assert.Equal(t, float64(7), value)
+
+	_, found = ValueAtPath(data, "nodes.1.id")
+	assert.False(t, found)
+}
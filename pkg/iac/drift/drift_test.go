@@ -0,0 +1,126 @@
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tofuPlanJson = `{
+  "resource_drift": [
+    {
+      "address": "aws_vpc.main",
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "change": {
+        "actions": ["update"],
+        "before": {"cidr_block": "10.0.0.0/16", "tags": {"env": "prod", "team": "core"}, "password": "a"},
+        "after": {"cidr_block": "10.0.0.0/16", "tags": {"env": "dev", "team": "core"}, "password": "b"},
+        "before_sensitive": {"password": true},
+        "after_sensitive": {"password": true}
+      }
+    },
+    {
+      "address": "aws_subnet.private[0]",
+      "mode": "managed",
+      "type": "aws_subnet",
+      "name": "private",
+      "change": {"actions": ["delete"], "before": {"id": "subnet-1"}, "after": null}
+    },
+    {
+      "address": "data.aws_caller_identity.current",
+      "mode": "data",
+      "type": "aws_caller_identity",
+      "name": "current",
+      "change": {"actions": ["update"], "before": {"id": "1"}, "after": {"id": "2"}}
+    }
+  ]
+}`
+
+func TestParseTofuPlan(t *testing.T) {
+	drifted, err := ParseTofuPlan([]byte(tofuPlanJson))
+	require.NoError(t, err)
+	require.Len(t, drifted, 2)
+
+	vpc := drifted[0]
+	assert.Equal(t, "aws_vpc.main", vpc.Address)
+	assert.Equal(t, ChangeModified, vpc.Change)
+	require.Len(t, vpc.Properties, 2)
+	assert.Equal(t, &PropertyDrift{Path: "password", Before: sensitiveValue, After: sensitiveValue}, vpc.Properties[0])
+	assert.Equal(t, &PropertyDrift{Path: "tags.env", Before: "prod", After: "dev"}, vpc.Properties[1])
+
+	subnet := drifted[1]
+	assert.Equal(t, "aws_subnet.private[0]", subnet.Address)
+	assert.Equal(t, ChangeMissing, subnet.Change)
+	assert.Empty(t, subnet.Properties)
+}
+
+const pulumiPreviewJson = `{
+  "steps": [
+    {
+      "op": "update",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/vpc:Vpc::main",
+      "oldState": {"type": "aws:ec2/vpc:Vpc", "inputs": {"tags": {"env": "dev"}}},
+      "newState": {"type": "aws:ec2/vpc:Vpc", "inputs": {"tags": {"env": "prod"}}},
+      "detailedDiff": {"tags.env": {"diffKind": "update", "inputDiff": true}}
+    },
+    {
+      "op": "create",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/subnet:Subnet::private",
+      "newState": {"type": "aws:ec2/subnet:Subnet"}
+    },
+    {
+      "op": "same",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/internetGateway:InternetGateway::igw"
+    },
+    {
+      "op": "update",
+      "urn": "urn:pulumi:prod::aws-vpc::pulumi:providers:aws::default",
+      "newState": {"type": "pulumi:providers:aws"}
+    }
+  ]
+}`
+
+func TestParsePulumiPreview(t *testing.T) {
+	drifted, err := ParsePulumiPreview([]byte(pulumiPreviewJson))
+	require.NoError(t, err)
+	require.Len(t, drifted, 2)
+
+	vpc := drifted[0]
+	assert.Equal(t, "aws:ec2/vpc:Vpc", vpc.Type)
+	assert.Equal(t, "main", vpc.Name)
+	assert.Equal(t, ChangeModified, vpc.Change)
+	assert.Equal(t, []*PropertyDrift{{Path: "tags.env", Before: "prod", After: "dev"}}, vpc.Properties)
+
+	subnet := drifted[1]
+	assert.Equal(t, "aws:ec2/subnet:Subnet", subnet.Type)
+	assert.Equal(t, ChangeMissing, subnet.Change)
+}
+
+func TestWrite(t *testing.T) {
+	report := &Report{Resource: "AwsVpc/prod/main", Provisioner: "tofu"}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, report, FormatJson))
+	decoded := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, []interface{}{}, decoded["drifted"])
+
+	report.Drifted = []*ResourceDrift{{Address: "aws_vpc.main", Type: "aws_vpc", Name: "main", Change: ChangeModified,
+		Properties: []*PropertyDrift{{Path: "tags.env", Before: "prod", After: "dev"}}}}
+	buf.Reset()
+	require.NoError(t, Write(buf, report, FormatJUnit))
+	assert.Contains(t, buf.String(), `<testsuite name="drift AwsVpc/prod/main" tests="1" failures="1">`)
+	assert.Contains(t, buf.String(), `tags.env: &#34;prod&#34; -&gt; &#34;dev&#34;`)
+
+	buf.Reset()
+	require.NoError(t, Write(buf, report, FormatTable))
+	assert.Contains(t, buf.String(), "aws_vpc.main")
+	assert.Contains(t, buf.String(), "1 resource(s) drifted in AwsVpc/prod/main")
+
+	assert.Error(t, Write(buf, report, Format("yaml")))
+}
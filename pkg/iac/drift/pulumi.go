@@ -0,0 +1,129 @@
+package drift
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/pkg/iac/propertydiff"
+)
+
+// pulumiPreview is the subset of `pulumi preview --json` used for drift detection.
+type pulumiPreview struct {
+	Steps []*pulumiStep `json:"steps"`
+}
+
+type pulumiStep struct {
+	Op             string                        `json:"op"`
+	Urn            string                        `json:"urn"`
+	OldState       *pulumiResourceState          `json:"oldState"`
+	NewState       *pulumiResourceState          `json:"newState"`
+	DiffReasons    []string                      `json:"diffReasons"`
+	ReplaceReasons []string                      `json:"replaceReasons"`
+	DetailedDiff   map[string]pulumiPropertyDiff `json:"detailedDiff"`
+}
+
+type pulumiResourceState struct {
+	Type    string                 `json:"type"`
+	Inputs  map[string]interface{} `json:"inputs"`
+	Outputs map[string]interface{} `json:"outputs"`
+}
+
+type pulumiPropertyDiff struct {
+	Kind      string `json:"diffKind"`
+	InputDiff bool   `json:"inputDiff"`
+}
+
+// ParsePulumiPreview builds the drifted resources from the output of `pulumi preview --refresh --json`.
+// The refresh reads live state into each step's old state, so every step that is not a no-op
+// marks a resource whose live state differs from what the program declares.
+func ParsePulumiPreview(previewJson []byte) ([]*ResourceDrift, error) {
+	preview := &pulumiPreview{}
+	if err := json.Unmarshal(previewJson, preview); err != nil {
+		return nil, errors.Wrap(err, "failed to parse pulumi preview json")
+	}
+
+	var drifted []*ResourceDrift
+	for _, step := range preview.Steps {
+		resourceDrift := &ResourceDrift{Address: step.Urn, Type: pulumiType(step), Name: pulumiName(step.Urn)}
+		switch step.Op {
+		case "create":
+			resourceDrift.Change = ChangeMissing
+		case "delete":
+			resourceDrift.Change = ChangeExtraneous
+		case "update", "replace":
+			resourceDrift.Change = ChangeModified
+			resourceDrift.RequiresReplacement = step.Op == "replace"
+			resourceDrift.Properties = pulumiProperties(step)
+		default:
+			// same, read and refresh steps, and the create/delete halves of a replacement
+			continue
+		}
+		if resourceDrift.Type == "pulumi:providers" || strings.HasPrefix(resourceDrift.Type, "pulumi:providers:") {
+			// Provider resources change with their configuration, not with cloud state
+			continue
+		}
+		drifted = append(drifted, resourceDrift)
+	}
+	return drifted, nil
+}
+
+// pulumiProperties lists the differing properties of an update step. Before is the value the
+// program declares and after the live value read by the refresh.
+func pulumiProperties(step *pulumiStep) []*PropertyDrift {
+	paths := make([]string, 0, len(step.DetailedDiff))
+	for path := range step.DetailedDiff {
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		// Providers without detailed diff support only report top-level keys
+		paths = append(paths, step.DiffReasons...)
+		paths = append(paths, step.ReplaceReasons...)
+	}
+	sort.Strings(paths)
+
+	var properties []*PropertyDrift
+	seen := map[string]bool{}
+	for _, path := range paths {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		property := &PropertyDrift{Path: path}
+		if step.NewState != nil {
+			property.Before = propertydiff.Lookup(step.NewState.Inputs, path)
+		}
+		if step.OldState != nil {
+			property.After = propertydiff.Lookup(step.OldState.Inputs, path)
+			if property.After == nil {
+				property.After = propertydiff.Lookup(step.OldState.Outputs, path)
+			}
+		}
+		properties = append(properties, property)
+	}
+	return properties
+}
+
+func pulumiType(step *pulumiStep) string {
+	for _, state := range []*pulumiResourceState{step.NewState, step.OldState} {
+		if state != nil && state.Type != "" {
+			return state.Type
+		}
+	}
+	// urn:pulumi:<stack>::<project>::<parent-type>$<type>::<name>
+	parts := strings.Split(step.Urn, "::")
+	if len(parts) < 4 {
+		return ""
+	}
+	qualifiedType := parts[2]
+	return qualifiedType[strings.LastIndex(qualifiedType, "$")+1:]
+}
+
+func pulumiName(urn string) string {
+	separator := strings.LastIndex(urn, "::")
+	if separator < 0 {
+		return urn
+	}
+	return urn[separator+len("::"):]
+}
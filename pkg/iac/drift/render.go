@@ -0,0 +1,174 @@
+package drift
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// Format is an output format of a drift report.
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatJson  Format = "json"
+	FormatJUnit Format = "junit"
+)
+
+// Formats lists the supported output formats.
+var Formats = []Format{FormatTable, FormatJson, FormatJUnit}
+
+// maxTableValueLen caps the width of values in the table output; the other formats keep them whole.
+const maxTableValueLen = 60
+
+// Write renders the report in the given format.
+func Write(w io.Writer, report *Report, format Format) error {
+	switch format {
+	case FormatTable:
+		return WriteTable(w, report)
+	case FormatJson:
+		return WriteJson(w, report)
+	case FormatJUnit:
+		return WriteJUnit(w, report)
+	default:
+		return errors.Errorf("unsupported drift output format %q, expected one of: %s", format, formatNames())
+	}
+}
+
+func formatNames() string {
+	names := make([]string, len(Formats))
+	for i, f := range Formats {
+		names[i] = string(f)
+	}
+	return strings.Join(names, ", ")
+}
+
+// WriteTable renders one row per drifted property, or per drifted resource without properties.
+func WriteTable(w io.Writer, report *Report) error {
+	if !report.HasDrift() {
+		_, err := fmt.Fprintf(w, "No drift detected for %s\n", report.Resource)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tCHANGE\tPROPERTY\tBEFORE\tAFTER")
+	for _, resource := range report.Drifted {
+		change := string(resource.Change)
+		if resource.RequiresReplacement {
+			change += " (replace)"
+		}
+		if len(resource.Properties) == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t\t\t\n", resourceLabel(resource), change)
+			continue
+		}
+		for _, property := range resource.Properties {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", resourceLabel(resource), change, property.Path,
+				truncate(formatValue(property.Before)), truncate(formatValue(property.After)))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d resource(s) drifted in %s\n", len(report.Drifted), report.Resource)
+	return err
+}
+
+// WriteJson renders the report as indented JSON.
+func WriteJson(w io.Writer, report *Report) error {
+	if report.Drifted == nil {
+		// Keep the field an array for consumers that iterate it
+		report = &Report{Resource: report.Resource, Provisioner: report.Provisioner, Drifted: []*ResourceDrift{}}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit renders the report as a JUnit test suite with a failed test case per drifted resource,
+// or a single passing test case when nothing drifted, so CI systems can chart drift over time.
+func WriteJUnit(w io.Writer, report *Report) error {
+	suite := junitTestSuite{Name: "drift " + report.Resource}
+	for _, resource := range report.Drifted {
+		var details []string
+		for _, property := range resource.Properties {
+			details = append(details, fmt.Sprintf("%s: %s -> %s", property.Path,
+				formatValue(property.Before), formatValue(property.After)))
+		}
+		suite.Cases = append(suite.Cases, junitTestCase{
+			ClassName: report.Resource,
+			Name:      resourceLabel(resource),
+			Failure: &junitFailure{
+				Message: fmt.Sprintf("%s is %s", resourceLabel(resource), resource.Change),
+				Type:    string(resource.Change),
+				Text:    strings.Join(details, "\n"),
+			},
+		})
+	}
+	if len(suite.Cases) == 0 {
+		suite.Cases = append(suite.Cases, junitTestCase{ClassName: report.Resource, Name: "no drift"})
+	}
+	suite.Tests = len(suite.Cases)
+	suite.Failures = len(report.Drifted)
+
+	out, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal junit report")
+	}
+	_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, out)
+	return err
+}
+
+// resourceLabel names a resource by its Tofu address, or by type and name for Pulumi URNs.
+func resourceLabel(resource *ResourceDrift) string {
+	if !strings.HasPrefix(resource.Address, "urn:") {
+		return resource.Address
+	}
+	return fmt.Sprintf("%s::%s", resource.Type, resource.Name)
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "-"
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(out)
+}
+
+func truncate(value string) string {
+	if len(value) <= maxTableValueLen {
+		return value
+	}
+	return value[:maxTableValueLen-3] + "..."
+}
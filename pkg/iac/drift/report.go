@@ -0,0 +1,56 @@
+// Package drift normalizes the refresh previews of Pulumi, OpenTofu and Terraform into a single
+// report of how deployed infrastructure differs from what was last declared.
+package drift
+
+// ChangeType describes how a live resource differs from its expected state.
+type ChangeType string
+
+const (
+	// ChangeModified means the live resource exists but some of its properties differ.
+	ChangeModified ChangeType = "modified"
+	// ChangeMissing means the resource is expected but does not exist, e.g. it was deleted outside the IaC run.
+	ChangeMissing ChangeType = "missing"
+	// ChangeExtraneous means the resource is tracked in state but no longer declared by the module.
+	ChangeExtraneous ChangeType = "extraneous"
+)
+
+// Report is the drift of one deployed cloud resource.
+type Report struct {
+	// Resource identifies the cloud resource, e.g. "AwsVpc/prod/main"
+	Resource string `json:"resource"`
+	// Provisioner is the IaC provisioner the drift was detected with: pulumi, tofu or terraform
+	Provisioner string `json:"provisioner"`
+	// Drifted lists the IaC resources that differ from their expected state
+	Drifted []*ResourceDrift `json:"drifted"`
+}
+
+// HasDrift reports whether any resource differs from its expected state.
+func (r *Report) HasDrift() bool {
+	return len(r.Drifted) > 0
+}
+
+// ResourceDrift is the drift of a single IaC resource, e.g. one aws_vpc of a Tofu module.
+type ResourceDrift struct {
+	// Address is the Pulumi URN or the Tofu/Terraform resource address
+	Address string `json:"address"`
+	// Type is the provider resource type, e.g. "aws:ec2/vpc:Vpc" or "aws_vpc"
+	Type string `json:"type"`
+	// Name is the logical name of the resource within the stack or module
+	Name   string     `json:"name"`
+	Change ChangeType `json:"change"`
+	// RequiresReplacement is set when reconciling the drift would replace the resource
+	RequiresReplacement bool `json:"requiresReplacement,omitempty"`
+	// Properties lists the differing properties of a modified resource
+	Properties []*PropertyDrift `json:"properties,omitempty"`
+}
+
+// PropertyDrift is a single property whose live value differs from the expected one.
+type PropertyDrift struct {
+	// Path is the property path, e.g. "tags.env" or "ingress[0].cidr_blocks"
+	Path string `json:"path"`
+	// Before is the expected value: the last applied state for Tofu/Terraform and the value
+	// declared by the manifest for Pulumi. It is omitted when the property was not set.
+	Before interface{} `json:"before,omitempty"`
+	// After is the live value read from the cloud provider. It is omitted when the property is unset.
+	After interface{} `json:"after,omitempty"`
+}
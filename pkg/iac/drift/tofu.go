@@ -0,0 +1,103 @@
+package drift
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/pkg/iac/propertydiff"
+)
+
+// tofuPlan is the subset of `tofu show -json <planfile>` used for drift detection.
+type tofuPlan struct {
+	ResourceDrift []*tofuResourceChange `json:"resource_drift"`
+}
+
+type tofuResourceChange struct {
+	Address string     `json:"address"`
+	Mode    string     `json:"mode"`
+	Type    string     `json:"type"`
+	Name    string     `json:"name"`
+	Change  tofuChange `json:"change"`
+}
+
+type tofuChange struct {
+	Actions         []string    `json:"actions"`
+	Before          interface{} `json:"before"`
+	After           interface{} `json:"after"`
+	BeforeSensitive interface{} `json:"before_sensitive"`
+	AfterSensitive  interface{} `json:"after_sensitive"`
+	ReplacePaths    interface{} `json:"replace_paths"`
+}
+
+// ParseTofuPlan builds the drifted resources from the JSON representation of a refresh-only
+// plan, as printed by `tofu show -json` or `terraform show -json`. Its resource_drift entries
+// hold the state before the refresh and the live values read during it.
+func ParseTofuPlan(planJson []byte) ([]*ResourceDrift, error) {
+	plan := &tofuPlan{}
+	if err := json.Unmarshal(planJson, plan); err != nil {
+		return nil, errors.Wrap(err, "failed to parse plan json")
+	}
+
+	var drifted []*ResourceDrift
+	for _, rc := range plan.ResourceDrift {
+		if rc.Mode == "data" {
+			// Data sources are re-read on every run and cannot drift
+			continue
+		}
+		resourceDrift := &ResourceDrift{
+			Address:             rc.Address,
+			Type:                rc.Type,
+			Name:                rc.Name,
+			RequiresReplacement: rc.Change.ReplacePaths != nil,
+		}
+		switch tofuAction(rc.Change.Actions) {
+		case "no-op", "read":
+			continue
+		case "create":
+			resourceDrift.Change = ChangeMissing
+		case "delete":
+			// A refresh that finds the resource gone plans to remove it from state
+			resourceDrift.Change = ChangeMissing
+		default:
+			resourceDrift.Change = ChangeModified
+			resourceDrift.Properties = tofuProperties(&rc.Change)
+		}
+		drifted = append(drifted, resourceDrift)
+	}
+	return drifted, nil
+}
+
+// tofuAction collapses the action list of a resource change, where ["delete", "create"]
+// and ["create", "delete"] denote a replacement.
+func tofuAction(actions []string) string {
+	if len(actions) == 2 {
+		return "replace"
+	}
+	if len(actions) == 0 {
+		return "no-op"
+	}
+	return actions[0]
+}
+
+// tofuProperties lists the differing properties of a modified resource, redacting sensitive values.
+func tofuProperties(change *tofuChange) []*PropertyDrift {
+	var properties []*PropertyDrift
+	for _, c := range propertydiff.Diff(change.Before, change.After) {
+		property := &PropertyDrift{Path: c.Path, Before: c.Before, After: c.After}
+		if propertydiff.IsMarked(change.BeforeSensitive, c.Path) || propertydiff.IsMarked(change.AfterSensitive, c.Path) {
+			property.Before, property.After = redact(c.Before), redact(c.After)
+		}
+		properties = append(properties, property)
+	}
+	return properties
+}
+
+// sensitiveValue replaces values that the provisioner marks as sensitive.
+const sensitiveValue = "(sensitive)"
+
+func redact(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return sensitiveValue
+}
@@ -0,0 +1,160 @@
+package plansummary
+
+import (
+	"bytes"
+	"testing"
+
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const tofuPlanJson = `{
+  "resource_changes": [
+    {
+      "address": "aws_instance.web",
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "web",
+      "change": {
+        "actions": ["delete", "create"],
+        "before": {"ami": "ami-1", "id": "i-1", "tags": {"env": "prod"}, "password": "a"},
+        "after": {"ami": "ami-2", "tags": {"env": "prod"}, "password": "b"},
+        "after_unknown": {"id": true},
+        "before_sensitive": {"password": true},
+        "after_sensitive": {"password": true},
+        "replace_paths": [["ami"]]
+      }
+    },
+    {
+      "address": "aws_s3_bucket.logs",
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "change": {"actions": ["create"], "before": null, "after": {"bucket": "logs"}}
+    },
+    {
+      "address": "aws_vpc.main",
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "change": {"actions": ["no-op"], "before": {"id": "vpc-1"}, "after": {"id": "vpc-1"}}
+    },
+    {
+      "address": "data.aws_region.current",
+      "mode": "data",
+      "type": "aws_region",
+      "name": "current",
+      "change": {"actions": ["read"]}
+    }
+  ]
+}`
+
+func TestParseTofuPlan(t *testing.T) {
+	changes, err := ParseTofuPlan([]byte(tofuPlanJson))
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	web := changes[0]
+	assert.Equal(t, planv1.PlanAction_replace, web.Action)
+	require.Len(t, web.Properties, 3)
+
+	ami := web.Properties[0]
+	assert.Equal(t, "ami", ami.Path)
+	assert.True(t, ami.ForcesReplacement)
+	assert.Equal(t, "ami-2", ami.After.GetStringValue())
+
+	id := web.Properties[1]
+	assert.Equal(t, "id", id.Path)
+	assert.True(t, id.KnownAfterApply)
+	assert.Nil(t, id.After)
+
+	password := web.Properties[2]
+	assert.True(t, password.Sensitive)
+	assert.Equal(t, sensitiveValue, password.Before.GetStringValue())
+
+	bucket := changes[1]
+	assert.Equal(t, planv1.PlanAction_create, bucket.Action)
+	assert.Empty(t, bucket.Properties)
+}
+
+const pulumiPreviewJson = `{
+  "steps": [
+    {
+      "op": "same",
+      "urn": "urn:pulumi:prod::aws-vpc::pulumi:pulumi:Stack::aws-vpc-prod"
+    },
+    {
+      "op": "update",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/vpc:Vpc::main",
+      "oldState": {"type": "aws:ec2/vpc:Vpc", "inputs": {"tags": {"env": "dev"}}},
+      "newState": {"type": "aws:ec2/vpc:Vpc", "inputs": {"tags": {"env": "prod"}}},
+      "detailedDiff": {"tags.env": {"diffKind": "update", "inputDiff": true}}
+    },
+    {
+      "op": "replace",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/subnet:Subnet::private",
+      "oldState": {"type": "aws:ec2/subnet:Subnet", "inputs": {"cidrBlock": "10.0.1.0/24"}},
+      "newState": {"type": "aws:ec2/subnet:Subnet", "inputs": {"cidrBlock": "04da6b54-80e4-46f7-96ec-b56ff0331ba9"}},
+      "detailedDiff": {"cidrBlock": {"diffKind": "update-replace", "inputDiff": true}}
+    },
+    {
+      "op": "create-replacement",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/subnet:Subnet::private"
+    },
+    {
+      "op": "delete",
+      "urn": "urn:pulumi:prod::aws-vpc::aws:ec2/internetGateway:InternetGateway::igw",
+      "oldState": {"type": "aws:ec2/internetGateway:InternetGateway"}
+    }
+  ]
+}`
+
+func TestParsePulumiPreview(t *testing.T) {
+	changes, err := ParsePulumiPreview([]byte(pulumiPreviewJson))
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	vpc := changes[0]
+	assert.Equal(t, planv1.PlanAction_update, vpc.Action)
+	assert.Equal(t, "main", vpc.Name)
+	require.Len(t, vpc.Properties, 1)
+	assert.Equal(t, "dev", vpc.Properties[0].Before.GetStringValue())
+	assert.Equal(t, "prod", vpc.Properties[0].After.GetStringValue())
+
+	subnet := changes[1]
+	assert.Equal(t, planv1.PlanAction_replace, subnet.Action)
+	require.Len(t, subnet.Properties, 1)
+	assert.True(t, subnet.Properties[0].ForcesReplacement)
+	assert.True(t, subnet.Properties[0].KnownAfterApply)
+	assert.Nil(t, subnet.Properties[0].After)
+
+	assert.Equal(t, planv1.PlanAction_delete, changes[2].Action)
+	assert.Equal(t, "aws:ec2/internetGateway:InternetGateway", changes[2].Type)
+}
+
+func TestWrite(t *testing.T) {
+	changes, err := ParseTofuPlan([]byte(tofuPlanJson))
+	require.NoError(t, err)
+	summary := &planv1.PlanSummary{Resource: "AwsVpc/prod/main", Provisioner: "tofu", Changes: changes}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, summary, FormatMarkdown))
+	assert.Contains(t, buf.String(), "**1** to create, **0** to update, **1** to replace, **0** to delete (tofu)")
+	assert.Contains(t, buf.String(), "| replace | `aws_instance.web` | `aws_instance` |")
+	assert.Contains(t, buf.String(), "| `ami` (forces replacement) | `\"ami-1\"` | `\"ami-2\"` |")
+	assert.Contains(t, buf.String(), "| `id` | `\"i-1\"` | _(known after apply)_ |")
+
+	buf.Reset()
+	require.NoError(t, Write(buf, summary, FormatJson))
+	decoded := &planv1.PlanSummary{}
+	require.NoError(t, protojson.Unmarshal(buf.Bytes(), decoded))
+	assert.Len(t, decoded.Changes, 2)
+
+	buf.Reset()
+	require.NoError(t, Write(buf, &planv1.PlanSummary{Resource: "AwsVpc/prod/main", Provisioner: "tofu"}, FormatMarkdown))
+	assert.Contains(t, buf.String(), "No changes.")
+
+	assert.Error(t, Write(buf, summary, Format("table")))
+}
@@ -0,0 +1,163 @@
+package plansummary
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"github.com/plantonhq/openmcf/pkg/iac/propertydiff"
+)
+
+const (
+	// pulumiUnknownValue is the placeholder Pulumi previews show for values computed during the update.
+	pulumiUnknownValue = "04da6b54-80e4-46f7-96ec-b56ff0331ba9"
+	// pulumiSecretValue is the placeholder Pulumi previews show for secret values.
+	pulumiSecretValue = "[secret]"
+)
+
+// pulumiPreview is the subset of `pulumi preview --json` used for plan summaries.
+type pulumiPreview struct {
+	Steps []*pulumiStep `json:"steps"`
+}
+
+type pulumiStep struct {
+	Op             string                        `json:"op"`
+	Urn            string                        `json:"urn"`
+	OldState       *pulumiResourceState          `json:"oldState"`
+	NewState       *pulumiResourceState          `json:"newState"`
+	DiffReasons    []string                      `json:"diffReasons"`
+	ReplaceReasons []string                      `json:"replaceReasons"`
+	DetailedDiff   map[string]pulumiPropertyDiff `json:"detailedDiff"`
+}
+
+type pulumiResourceState struct {
+	Type   string                 `json:"type"`
+	Inputs map[string]interface{} `json:"inputs"`
+}
+
+type pulumiPropertyDiff struct {
+	Kind string `json:"diffKind"`
+}
+
+// ParsePulumiPreview builds the resource changes from the output of `pulumi preview --json`.
+func ParsePulumiPreview(previewJson []byte) ([]*planv1.PlanResourceChange, error) {
+	preview := &pulumiPreview{}
+	if err := json.Unmarshal(previewJson, preview); err != nil {
+		return nil, errors.Wrap(err, "failed to parse pulumi preview json")
+	}
+
+	var changes []*planv1.PlanResourceChange
+	for _, step := range preview.Steps {
+		change := &planv1.PlanResourceChange{Address: step.Urn, Type: pulumiType(step), Name: pulumiName(step.Urn)}
+		switch step.Op {
+		case "create":
+			change.Action = planv1.PlanAction_create
+		case "delete":
+			change.Action = planv1.PlanAction_delete
+		case "update":
+			change.Action = planv1.PlanAction_update
+		case "replace":
+			change.Action = planv1.PlanAction_replace
+		default:
+			// same, read and refresh steps, and the create/delete halves of a replacement
+			continue
+		}
+		if change.Type == "pulumi:pulumi:Stack" || strings.HasPrefix(change.Type, "pulumi:providers:") {
+			// The stack and provider resources are bookkeeping rather than infrastructure
+			continue
+		}
+		if change.Action == planv1.PlanAction_update || change.Action == planv1.PlanAction_replace {
+			properties, err := pulumiProperties(step)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to summarize changes of %s", step.Urn)
+			}
+			change.Properties = properties
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// pulumiProperties lists the properties an update or replacement changes.
+func pulumiProperties(step *pulumiStep) ([]*planv1.PlanPropertyChange, error) {
+	paths := make([]string, 0, len(step.DetailedDiff))
+	for path := range step.DetailedDiff {
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		// Providers without detailed diff support only report top-level keys
+		paths = append(paths, step.DiffReasons...)
+		for _, path := range step.ReplaceReasons {
+			if !containsString(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	var properties []*planv1.PlanPropertyChange
+	for _, path := range paths {
+		var before, after interface{}
+		if step.OldState != nil {
+			before = propertydiff.Lookup(step.OldState.Inputs, path)
+		}
+		if step.NewState != nil {
+			after = propertydiff.Lookup(step.NewState.Inputs, path)
+		}
+		property := &planv1.PlanPropertyChange{
+			Path:              path,
+			Sensitive:         before == pulumiSecretValue || after == pulumiSecretValue,
+			KnownAfterApply:   after == pulumiUnknownValue,
+			ForcesReplacement: strings.HasSuffix(step.DetailedDiff[path].Kind, "-replace") || containsString(step.ReplaceReasons, path),
+		}
+		if property.Sensitive {
+			before, after = redact(before), redact(after)
+		}
+		if property.KnownAfterApply {
+			after = nil
+		}
+		var err error
+		if property.Before, err = toValue(before); err != nil {
+			return nil, err
+		}
+		if property.After, err = toValue(after); err != nil {
+			return nil, err
+		}
+		properties = append(properties, property)
+	}
+	return properties, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func pulumiType(step *pulumiStep) string {
+	for _, state := range []*pulumiResourceState{step.NewState, step.OldState} {
+		if state != nil && state.Type != "" {
+			return state.Type
+		}
+	}
+	// urn:pulumi:<stack>::<project>::<parent-type>$<type>::<name>
+	parts := strings.Split(step.Urn, "::")
+	if len(parts) < 4 {
+		return ""
+	}
+	qualifiedType := parts[2]
+	return qualifiedType[strings.LastIndex(qualifiedType, "$")+1:]
+}
+
+func pulumiName(urn string) string {
+	separator := strings.LastIndex(urn, "::")
+	if separator < 0 {
+		return urn
+	}
+	return urn[separator+len("::"):]
+}
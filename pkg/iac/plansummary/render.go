@@ -0,0 +1,144 @@
+package plansummary
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Format is an output format of a plan summary.
+type Format string
+
+const (
+	FormatJson     Format = "json"
+	FormatMarkdown Format = "markdown"
+)
+
+// Formats lists the supported output formats.
+var Formats = []Format{FormatJson, FormatMarkdown}
+
+// maxMarkdownValueLen caps the width of values in markdown tables; the JSON output keeps them whole.
+const maxMarkdownValueLen = 80
+
+// Write renders the summary in the given format.
+func Write(w io.Writer, summary *planv1.PlanSummary, format Format) error {
+	switch format {
+	case FormatJson:
+		return WriteJson(w, summary)
+	case FormatMarkdown:
+		return WriteMarkdown(w, summary)
+	default:
+		return errors.Errorf("unsupported plan output format %q, expected one of: %s", format, formatNames())
+	}
+}
+
+func formatNames() string {
+	names := make([]string, len(Formats))
+	for i, f := range Formats {
+		names[i] = string(f)
+	}
+	return strings.Join(names, ", ")
+}
+
+// WriteJson renders the summary as indented protobuf JSON.
+func WriteJson(w io.Writer, summary *planv1.PlanSummary) error {
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(summary)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal plan summary")
+	}
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
+
+// WriteMarkdown renders the summary as markdown suitable for a pull request comment: a table of
+// changed resources followed by a collapsed section with the changed properties of each resource.
+func WriteMarkdown(w io.Writer, summary *planv1.PlanSummary) error {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "### Plan for `%s`\n\n", summary.Resource)
+	if !HasChanges(summary) {
+		fmt.Fprintf(b, "No changes. The infrastructure matches the manifest (%s).\n", summary.Provisioner)
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	counts := Counts(summary)
+	var totals []string
+	for _, action := range Actions {
+		totals = append(totals, fmt.Sprintf("**%d** to %s", counts[action], action))
+	}
+	fmt.Fprintf(b, "%s (%s)\n\n", strings.Join(totals, ", "), summary.Provisioner)
+
+	b.WriteString("| Action | Resource | Type |\n")
+	b.WriteString("|--------|----------|------|\n")
+	for _, change := range summary.Changes {
+		fmt.Fprintf(b, "| %s | %s | %s |\n", change.Action, code(resourceLabel(change)), code(change.Type))
+	}
+
+	var withProperties []*planv1.PlanResourceChange
+	for _, change := range summary.Changes {
+		if len(change.Properties) > 0 {
+			withProperties = append(withProperties, change)
+		}
+	}
+	if len(withProperties) > 0 {
+		b.WriteString("\n<details>\n<summary>Property changes</summary>\n")
+		for _, change := range withProperties {
+			fmt.Fprintf(b, "\n#### %s %s\n\n", change.Action, code(resourceLabel(change)))
+			b.WriteString("| Property | Before | After |\n")
+			b.WriteString("|----------|--------|-------|\n")
+			for _, property := range change.Properties {
+				path := code(property.Path)
+				if property.ForcesReplacement {
+					path += " (forces replacement)"
+				}
+				after := markdownValue(property.After)
+				if property.KnownAfterApply {
+					after = "_(known after apply)_"
+				}
+				fmt.Fprintf(b, "| %s | %s | %s |\n", path, markdownValue(property.Before), after)
+			}
+		}
+		b.WriteString("\n</details>\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// resourceLabel names a resource by its Tofu address, or by type and name for Pulumi URNs.
+func resourceLabel(change *planv1.PlanResourceChange) string {
+	if !strings.HasPrefix(change.Address, "urn:") {
+		return change.Address
+	}
+	return fmt.Sprintf("%s::%s", change.Type, change.Name)
+}
+
+func markdownValue(value *structpb.Value) string {
+	if value == nil {
+		return "-"
+	}
+	// encoding/json keeps the output stable, protojson randomizes whitespace
+	out, err := json.Marshal(value.AsInterface())
+	if err != nil {
+		return "-"
+	}
+	formatted := string(out)
+	if len(formatted) > maxMarkdownValueLen {
+		formatted = formatted[:maxMarkdownValueLen-3] + "..."
+	}
+	return code(formatted)
+}
+
+// code formats text as an inline code span that is safe to use in a table cell.
+func code(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	if strings.Contains(text, "`") {
+		return "`` " + text + " ``"
+	}
+	return "`" + text + "`"
+}
@@ -0,0 +1,46 @@
+// Package plansummary converts the plans of Pulumi, OpenTofu and Terraform into a single
+// PlanSummary and renders it, so plans read the same regardless of the provisioner.
+package plansummary
+
+import (
+	"github.com/pkg/errors"
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// sensitiveValue replaces values that the provisioner marks as sensitive.
+const sensitiveValue = "(sensitive)"
+
+// Actions lists the plan actions in the order they are reported.
+var Actions = []planv1.PlanAction{
+	planv1.PlanAction_create,
+	planv1.PlanAction_update,
+	planv1.PlanAction_replace,
+	planv1.PlanAction_delete,
+}
+
+// Counts returns the number of resources per plan action.
+func Counts(summary *planv1.PlanSummary) map[planv1.PlanAction]int {
+	counts := map[planv1.PlanAction]int{}
+	for _, change := range summary.Changes {
+		counts[change.Action]++
+	}
+	return counts
+}
+
+// HasChanges reports whether the plan changes any resource.
+func HasChanges(summary *planv1.PlanSummary) bool {
+	return len(summary.Changes) > 0
+}
+
+// toValue converts a decoded JSON value to a protobuf value, returning nil for unset values.
+func toValue(value interface{}) (*structpb.Value, error) {
+	if value == nil {
+		return nil, nil
+	}
+	v, err := structpb.NewValue(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert property value")
+	}
+	return v, nil
+}
@@ -0,0 +1,134 @@
+package plansummary
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	planv1 "github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/plan/v1"
+	"github.com/plantonhq/openmcf/pkg/iac/propertydiff"
+)
+
+// tofuPlan is the subset of `tofu show -json <planfile>` used for plan summaries.
+type tofuPlan struct {
+	ResourceChanges []*tofuResourceChange `json:"resource_changes"`
+}
+
+type tofuResourceChange struct {
+	Address string     `json:"address"`
+	Mode    string     `json:"mode"`
+	Type    string     `json:"type"`
+	Name    string     `json:"name"`
+	Change  tofuChange `json:"change"`
+}
+
+type tofuChange struct {
+	Actions         []string        `json:"actions"`
+	Before          interface{}     `json:"before"`
+	After           interface{}     `json:"after"`
+	AfterUnknown    interface{}     `json:"after_unknown"`
+	BeforeSensitive interface{}     `json:"before_sensitive"`
+	AfterSensitive  interface{}     `json:"after_sensitive"`
+	ReplacePaths    [][]interface{} `json:"replace_paths"`
+}
+
+// ParseTofuPlan builds the resource changes from the JSON representation of a saved plan,
+// as printed by `tofu show -json` or `terraform show -json`.
+func ParseTofuPlan(planJson []byte) ([]*planv1.PlanResourceChange, error) {
+	plan := &tofuPlan{}
+	if err := json.Unmarshal(planJson, plan); err != nil {
+		return nil, errors.Wrap(err, "failed to parse plan json")
+	}
+
+	var changes []*planv1.PlanResourceChange
+	for _, rc := range plan.ResourceChanges {
+		if rc.Mode == "data" {
+			// Data sources are read, never changed
+			continue
+		}
+		change := &planv1.PlanResourceChange{Address: rc.Address, Type: rc.Type, Name: rc.Name}
+		switch tofuAction(rc.Change.Actions) {
+		case "create":
+			change.Action = planv1.PlanAction_create
+		case "delete":
+			change.Action = planv1.PlanAction_delete
+		case "update":
+			change.Action = planv1.PlanAction_update
+		case "replace":
+			change.Action = planv1.PlanAction_replace
+		default:
+			// no-op and read
+			continue
+		}
+		if change.Action == planv1.PlanAction_update || change.Action == planv1.PlanAction_replace {
+			properties, err := tofuProperties(&rc.Change)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to summarize changes of %s", rc.Address)
+			}
+			change.Properties = properties
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// tofuAction collapses the action list of a resource change, where ["delete", "create"]
+// and ["create", "delete"] denote a replacement.
+func tofuAction(actions []string) string {
+	if len(actions) == 2 {
+		return "replace"
+	}
+	if len(actions) == 0 {
+		return "no-op"
+	}
+	return actions[0]
+}
+
+// tofuProperties lists the properties an update or replacement changes. Values that are
+// computed during apply are absent from after and flagged as known after apply.
+func tofuProperties(change *tofuChange) ([]*planv1.PlanPropertyChange, error) {
+	var replacePaths []string
+	for _, segments := range change.ReplacePaths {
+		replacePaths = append(replacePaths, propertydiff.Join(segments))
+	}
+
+	var properties []*planv1.PlanPropertyChange
+	for _, c := range propertydiff.Diff(change.Before, change.After) {
+		property := &planv1.PlanPropertyChange{
+			Path:              c.Path,
+			Sensitive:         propertydiff.IsMarked(change.BeforeSensitive, c.Path) || propertydiff.IsMarked(change.AfterSensitive, c.Path),
+			KnownAfterApply:   c.After == nil && propertydiff.IsMarked(change.AfterUnknown, c.Path),
+			ForcesReplacement: isWithinAny(c.Path, replacePaths),
+		}
+		before, after := c.Before, c.After
+		if property.Sensitive {
+			before, after = redact(before), redact(after)
+		}
+		var err error
+		if property.Before, err = toValue(before); err != nil {
+			return nil, err
+		}
+		if property.After, err = toValue(after); err != nil {
+			return nil, err
+		}
+		properties = append(properties, property)
+	}
+	return properties, nil
+}
+
+// isWithinAny reports whether path equals or is nested below any of the given paths.
+func isWithinAny(path string, paths []string) bool {
+	for _, p := range paths {
+		if path == p || strings.HasPrefix(path, p+".") || strings.HasPrefix(path, p+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return sensitiveValue
+}
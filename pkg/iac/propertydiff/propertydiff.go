@@ -0,0 +1,188 @@
+// Package propertydiff compares the JSON resource properties reported by Pulumi, OpenTofu and
+// Terraform and addresses them by property paths such as `ingress[0].tags["a.b"]`.
+package propertydiff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Change is a single property whose value differs between two versions of a resource.
+type Change struct {
+	// Path is the property path, e.g. "tags.env" or "ingress[0].cidr_blocks"
+	Path string
+	// Before is the old value, or nil if the property was not set
+	Before interface{}
+	// After is the new value, or nil if the property is unset
+	After interface{}
+}
+
+// Diff returns the leaf properties that differ between before and after.
+// Maps are compared key by key and lists of equal length element by element; any other
+// difference is reported for the whole value.
+func Diff(before, after interface{}) []*Change {
+	var changes []*Change
+	var walk func(path string, before, after interface{})
+	walk = func(path string, before, after interface{}) {
+		if reflect.DeepEqual(before, after) {
+			return
+		}
+		beforeMap, isBeforeMap := before.(map[string]interface{})
+		afterMap, isAfterMap := after.(map[string]interface{})
+		if isBeforeMap && isAfterMap {
+			for _, key := range unionKeys(beforeMap, afterMap) {
+				walk(joinKey(path, key), beforeMap[key], afterMap[key])
+			}
+			return
+		}
+		beforeList, isBeforeList := before.([]interface{})
+		afterList, isAfterList := after.([]interface{})
+		if isBeforeList && isAfterList && len(beforeList) == len(afterList) {
+			for i := range beforeList {
+				walk(fmt.Sprintf("%s[%d]", path, i), beforeList[i], afterList[i])
+			}
+			return
+		}
+		changes = append(changes, &Change{Path: path, Before: before, After: after})
+	}
+	walk("", before, after)
+	return changes
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// joinKey appends a map key to a property path, quoting keys that are not plain identifiers.
+func joinKey(path, key string) string {
+	if key == "" || strings.ContainsAny(key, `.[]"`) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// Join builds a property path from map keys (strings) and list indices (ints), the inverse of Split.
+func Join(segments []interface{}) string {
+	path := ""
+	for _, segment := range segments {
+		switch s := segment.(type) {
+		case string:
+			path = joinKey(path, s)
+		case int:
+			path = fmt.Sprintf("%s[%d]", path, s)
+		case float64:
+			// Indices decoded from JSON are floats
+			path = fmt.Sprintf("%s[%d]", path, int(s))
+		}
+	}
+	return path
+}
+
+// Split parses a property path such as `ingress[0].tags["a.b"]` into map keys (strings)
+// and list indices (ints).
+func Split(path string) ([]interface{}, error) {
+	var segments []interface{}
+	for rest := path; rest != ""; {
+		switch {
+		case rest[0] == '.':
+			rest = rest[1:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if strings.HasPrefix(rest, `["`) {
+				end = strings.Index(rest, `"]`) + 1
+			}
+			if end <= 0 {
+				return nil, fmt.Errorf("unterminated [ in property path %q", path)
+			}
+			inner := rest[1:end]
+			if index, err := strconv.Atoi(inner); err == nil {
+				segments = append(segments, index)
+			} else if key, err := strconv.Unquote(inner); err == nil {
+				segments = append(segments, key)
+			} else {
+				return nil, fmt.Errorf("invalid segment %s in property path %q", inner, path)
+			}
+			rest = rest[end+1:]
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			segments = append(segments, rest[:end])
+			rest = rest[end:]
+		}
+	}
+	return segments, nil
+}
+
+// Lookup returns the value at path within value, or nil if the path does not exist.
+func Lookup(value interface{}, path string) interface{} {
+	segments, err := Split(path)
+	if err != nil {
+		return nil
+	}
+	for _, segment := range segments {
+		switch s := segment.(type) {
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			value = m[s]
+		case int:
+			l, ok := value.([]interface{})
+			if !ok || s < 0 || s >= len(l) {
+				return nil
+			}
+			value = l[s]
+		}
+	}
+	return value
+}
+
+// IsMarked reports whether path, or any value containing it, is marked true in marks. Tofu and
+// Terraform describe sensitive and unknown values with such structures, which mirror the shape
+// of the values they accompany, e.g. {"tags": {"secret": true}}.
+func IsMarked(marks interface{}, path string) bool {
+	segments, err := Split(path)
+	if err != nil {
+		return false
+	}
+	for _, segment := range segments {
+		if isMarked, ok := marks.(bool); ok {
+			return isMarked
+		}
+		switch s := segment.(type) {
+		case string:
+			m, ok := marks.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			marks = m[s]
+		case int:
+			l, ok := marks.([]interface{})
+			if !ok || s >= len(l) {
+				return false
+			}
+			marks = l[s]
+		}
+	}
+	isMarked, _ := marks.(bool)
+	return isMarked
+}
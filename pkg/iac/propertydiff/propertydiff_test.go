@@ -0,0 +1,46 @@
+package propertydiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	before := map[string]interface{}{
+		"cidr":    "10.0.0.0/16",
+		"tags":    map[string]interface{}{"env": "prod", "a.b": "x"},
+		"subnets": []interface{}{"a", "b"},
+	}
+	after := map[string]interface{}{
+		"cidr":    "10.0.0.0/16",
+		"tags":    map[string]interface{}{"env": "dev", "a.b": "y"},
+		"subnets": []interface{}{"a"},
+	}
+	assert.Equal(t, []*Change{
+		{Path: "subnets", Before: []interface{}{"a", "b"}, After: []interface{}{"a"}},
+		{Path: `tags["a.b"]`, Before: "x", After: "y"},
+		{Path: "tags.env", Before: "prod", After: "dev"},
+	}, Diff(before, after))
+}
+
+func TestSplit(t *testing.T) {
+	segments, err := Split(`ingress[0].tags["a.b"]`)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"ingress", 0, "tags", "a.b"}, segments)
+
+	_, err = Split("ingress[0")
+	assert.Error(t, err)
+
+	assert.Equal(t, `ingress[0].tags["a.b"]`, Join(segments))
+	assert.Equal(t, "ingress[1].port", Join([]interface{}{"ingress", float64(1), "port"}))
+}
+
+func TestIsMarked(t *testing.T) {
+	marks := map[string]interface{}{"password": true, "ingress": []interface{}{map[string]interface{}{"key": true}}}
+	assert.True(t, IsMarked(marks, "password"))
+	assert.True(t, IsMarked(marks, "ingress[0].key"))
+	assert.False(t, IsMarked(marks, "ingress[0].port"))
+	assert.True(t, IsMarked(true, "anything.below"))
+}
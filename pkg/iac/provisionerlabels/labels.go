@@ -4,4 +4,7 @@ const (
 	// ProvisionerLabelKey specifies which IaC provisioner to use
 	// Supported values: "pulumi", "tofu", "terraform" (case-insensitive)
 	ProvisionerLabelKey = "openmcf.org/provisioner"
+
+	// CredentialLabelKey names the credential, by name or ID, that the backend deploys the resource with
+	CredentialLabelKey = "openmcf.org/credential"
 )
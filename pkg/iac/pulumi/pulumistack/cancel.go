@@ -18,7 +18,7 @@ import (
 
 // Cancel cancels any in-progress operations on a Pulumi stack.
 // This is useful when a stack is locked due to a crashed or interrupted operation.
-func Cancel(moduleDir, stackFqdn, targetManifestPath string, valueOverrides map[string]string, moduleVersion string, noCleanup bool) error {
+func Cancel(moduleDir, stackFqdn, targetManifestPath string, valueOverrides []manifest.Override, moduleVersion string, noCleanup bool) error {
 	manifestObject, err := manifest.LoadWithOverrides(targetManifestPath, valueOverrides)
 	if err != nil {
 		return errors.Wrapf(err, "failed to override values in target manifest file")
@@ -20,7 +20,7 @@ import (
 // Init initializes a new Pulumi stack for the given manifest.
 // It extracts the stack FQDN from the manifest, prepares the module directory,
 // and runs `pulumi stack init` to create the stack in the backend.
-func Init(moduleDir, stackFqdn, targetManifestPath string, valueOverrides map[string]string, moduleVersion string, noCleanup bool) error {
+func Init(moduleDir, stackFqdn, targetManifestPath string, valueOverrides []manifest.Override, moduleVersion string, noCleanup bool) error {
 	manifestObject, err := manifest.LoadWithOverrides(targetManifestPath, valueOverrides)
 	if err != nil {
 		return errors.Wrapf(err, "failed to override values in target manifest file")
@@ -0,0 +1,38 @@
+package pulumistack
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GetOutputs returns the outputs of a Pulumi stack, keyed by output name, by running
+// `pulumi stack output --json --show-secrets`.
+func GetOutputs(stackFqdn string) (map[string]interface{}, error) {
+	if stackFqdn == "" {
+		return nil, errors.New("Pulumi stack FQDN is required to read stack outputs")
+	}
+
+	pulumiCmd := exec.Command("pulumi", "stack", "output", "--json", "--show-secrets", "--stack", stackFqdn)
+	pulumiCmd.Env = os.Environ()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	pulumiCmd.Stdout = stdout
+	pulumiCmd.Stderr = stderr
+
+	if err := pulumiCmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read outputs of pulumi stack %s: %s",
+			stackFqdn, strings.TrimSpace(stderr.String()))
+	}
+
+	outputs := map[string]interface{}{}
+	if err := json.Unmarshal(stdout.Bytes(), &outputs); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse outputs of pulumi stack %s", stackFqdn)
+	}
+	return outputs, nil
+}
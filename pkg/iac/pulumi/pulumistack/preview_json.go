@@ -0,0 +1,51 @@
+package pulumistack
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/iac/stackinput/stackinputproviderconfig"
+)
+
+// PreviewJson runs `pulumi preview --json` for the manifest's stack and returns the JSON preview.
+// With refresh, the state of the stack's resources is read from the cloud before the diff, so the
+// preview shows how live resources differ from the manifest. The preview does not change the stack.
+// The parameters mirror Run.
+func PreviewJson(moduleDir, stackFqdn, targetManifestPath string, valueOverrides []manifest.Override, moduleVersion string,
+	noCleanup bool, kubeContext string, stackInputFilePath string, providerConfig *stackinputproviderconfig.ProviderConfig,
+	refresh bool) ([]byte, error) {
+	prepared, err := prepareStack(moduleDir, stackFqdn, targetManifestPath, valueOverrides, moduleVersion, noCleanup,
+		stackInputFilePath, providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer prepared.cleanup()
+
+	args := []string{"preview", "--stack", prepared.stackFqdn, "--json", "--non-interactive"}
+	if refresh {
+		args = append(args, "--refresh")
+	}
+
+	pulumiCmd := exec.Command("pulumi", args...)
+	pulumiCmd.Env = prepared.env(kubeContext)
+	pulumiCmd.Dir = prepared.modulePath
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	pulumiCmd.Stdout = stdout
+	pulumiCmd.Stderr = stderr
+
+	prepared.logExecutionMode()
+	if err := pulumiCmd.Run(); err != nil {
+		// Diagnostics are part of the JSON document, so show whichever stream has them
+		details := strings.TrimSpace(stderr.String())
+		if details == "" {
+			details = strings.TrimSpace(stdout.String())
+		}
+		return nil, errors.Wrapf(err, "failed to preview pulumi stack %s: %s", prepared.stackFqdn, details)
+	}
+	return stdout.Bytes(), nil
+}
@@ -21,11 +21,86 @@ import (
 )
 
 func Run(moduleDir, stackFqdn, targetManifestPath string, pulumiOperation pulumi.PulumiOperationType,
-	isUpdatePreview bool, isAutoApprove bool, valueOverrides map[string]string, showDiff bool, moduleVersion string, noCleanup bool,
+	isUpdatePreview bool, isAutoApprove bool, valueOverrides []manifest.Override, showDiff bool, moduleVersion string, noCleanup bool,
 	kubeContext string, stackInputFilePath string, providerConfig *stackinputproviderconfig.ProviderConfig) error {
+	prepared, err := prepareStack(moduleDir, stackFqdn, targetManifestPath, valueOverrides, moduleVersion, noCleanup,
+		stackInputFilePath, providerConfig)
+	if err != nil {
+		return err
+	}
+	defer prepared.cleanup()
+
+	// Map to Pulumi CLI verbs
+	op := pulumiOperation.String()
+	switch pulumiOperation {
+	case pulumi.PulumiOperationType_update:
+		op = "up"
+	case pulumi.PulumiOperationType_refresh:
+		op = "refresh"
+	case pulumi.PulumiOperationType_destroy:
+		op = "destroy"
+	}
+	if isUpdatePreview {
+		op = "preview"
+	}
+
+	// Build pulumi command with optional flags
+	args := []string{op, "--stack", prepared.stackFqdn}
+	if isAutoApprove {
+		args = append(args, "--yes")
+		// For 'pulumi up', skip preview to avoid TTY prompts in CI/non-interactive shells
+		if op == "up" {
+			args = append(args, "--skip-preview")
+		}
+	}
+	if showDiff {
+		args = append(args, "--diff")
+	}
+
+	pulumiCmd := exec.Command("pulumi", args...)
+
+	// Set environment variables
+	pulumiCmd.Env = prepared.env(kubeContext)
+
+	// Set the working directory to the repository path
+	pulumiCmd.Dir = prepared.modulePath
+
+	// Set stdin, stdout, and stderr directly to the terminal for interactive output
+	// This allows Pulumi to detect TTY and use the interactive tree view
+	pulumiCmd.Stdin = os.Stdin
+	pulumiCmd.Stdout = os.Stdout
+	pulumiCmd.Stderr = os.Stderr
+
+	// Log execution mode and directory info (debug level only)
+	prepared.logExecutionMode()
+	fmt.Println()
+
+	// Print handoff message after all setup is complete
+	cliprint.PrintHandoff("Pulumi")
+
+	if err := pulumiCmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to execute pulumi command %s", op)
+	}
+
+	return nil
+}
+
+// preparedStack holds everything needed to run a command against a staged Pulumi module.
+type preparedStack struct {
+	stackFqdn          string
+	modulePath         string
+	stackInputFilePath string
+	pathResult         *pulumimodule.GetPathResult
+	cleanup            func()
+}
+
+// prepareStack loads the manifest, resolves the stack, stages the module and writes the
+// stack input file. The caller must run cleanup when done.
+func prepareStack(moduleDir, stackFqdn, targetManifestPath string, valueOverrides []manifest.Override, moduleVersion string,
+	noCleanup bool, stackInputFilePath string, providerConfig *stackinputproviderconfig.ProviderConfig) (*preparedStack, error) {
 	manifestObject, err := manifest.LoadWithOverrides(targetManifestPath, valueOverrides)
 	if err != nil {
-		return errors.Wrapf(err, "failed to override values in target manifest file")
+		return nil, errors.Wrapf(err, "failed to override values in target manifest file")
 	}
 
 	// Try to extract backend configuration from manifest labels
@@ -41,33 +116,35 @@ func Run(moduleDir, stackFqdn, targetManifestPath string, pulumiOperation pulumi
 
 	// Validate that we have a stack FQDN
 	if finalStackFqdn == "" {
-		return errors.New("Pulumi stack FQDN is required. Provide it via --stack flag or set pulumi.openmcf.org/stack.fqdn label in manifest")
+		return nil, errors.New("Pulumi stack FQDN is required. Provide it via --stack flag or set pulumi.openmcf.org/stack.fqdn label in manifest")
 	}
 
 	kindName, err := crkreflect.ExtractKindFromProto(manifestObject)
 	if err != nil {
-		return errors.Wrapf(err, "failed to extract kind name from manifest proto")
+		return nil, errors.Wrapf(err, "failed to extract kind name from manifest proto")
 	}
 
 	pathResult, err := pulumimodule.GetPath(moduleDir, finalStackFqdn, kindName, moduleVersion, noCleanup)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get pulumi-module directory")
+		return nil, errors.Wrapf(err, "failed to get pulumi-module directory")
 	}
 
 	// Setup cleanup to run after execution
+	cleanup := func() {}
 	if pathResult.ShouldCleanup {
-		defer func() {
+		cleanup = func() {
 			if cleanupErr := pathResult.CleanupFunc(); cleanupErr != nil {
 				fmt.Printf("Warning: failed to cleanup workspace copy: %v\n", cleanupErr)
 			}
-		}()
+		}
 	}
 
 	pulumiModuleRepoPath := pathResult.ModulePath
 
 	pulumiProjectName, err := ExtractProjectName(finalStackFqdn)
 	if err != nil {
-		return errors.Wrapf(err, "failed to extract project name from %s stack fqdn", finalStackFqdn)
+		cleanup()
+		return nil, errors.Wrapf(err, "failed to extract project name from %s stack fqdn", finalStackFqdn)
 	}
 
 	// Determine stack input file path:
@@ -81,82 +158,50 @@ func Run(moduleDir, stackFqdn, targetManifestPath string, pulumiOperation pulumi
 		// Build stack input from manifest
 		stackInputYamlContent, err := stackinput.BuildStackInputYaml(manifestObject, providerConfig)
 		if err != nil {
-			return errors.Wrap(err, "failed to build stack input yaml")
+			cleanup()
+			return nil, errors.Wrap(err, "failed to build stack input yaml")
 		}
 
 		// Write stack input to file (avoids env var size limits for large manifests)
 		finalStackInputFilePath = filepath.Join(pulumiModuleRepoPath, "stack-input.yaml")
 		if err := os.WriteFile(finalStackInputFilePath, []byte(stackInputYamlContent), 0600); err != nil {
-			return errors.Wrap(err, "failed to write stack input file")
+			cleanup()
+			return nil, errors.Wrap(err, "failed to write stack input file")
 		}
 	}
 
 	// Update project name in Pulumi.yaml
 	// For binary mode, we regenerate the Pulumi.yaml with the correct project name
 	if err := UpdateProjectNameInPulumiYaml(pulumiModuleRepoPath, pulumiProjectName); err != nil {
-		return errors.Wrapf(err, "failed to update project name in %s/Pulumi.yaml", pulumiModuleRepoPath)
+		cleanup()
+		return nil, errors.Wrapf(err, "failed to update project name in %s/Pulumi.yaml", pulumiModuleRepoPath)
 	}
 
-	// Map to Pulumi CLI verbs
-	op := pulumiOperation.String()
-	switch pulumiOperation {
-	case pulumi.PulumiOperationType_update:
-		op = "up"
-	case pulumi.PulumiOperationType_refresh:
-		op = "refresh"
-	case pulumi.PulumiOperationType_destroy:
-		op = "destroy"
-	}
-	if isUpdatePreview {
-		op = "preview"
-	}
-
-	// Build pulumi command with optional flags
-	args := []string{op, "--stack", finalStackFqdn}
-	if isAutoApprove {
-		args = append(args, "--yes")
-		// For 'pulumi up', skip preview to avoid TTY prompts in CI/non-interactive shells
-		if op == "up" {
-			args = append(args, "--skip-preview")
-		}
-	}
-	if showDiff {
-		args = append(args, "--diff")
-	}
-
-	pulumiCmd := exec.Command("pulumi", args...)
+	return &preparedStack{
+		stackFqdn:          finalStackFqdn,
+		modulePath:         pulumiModuleRepoPath,
+		stackInputFilePath: finalStackInputFilePath,
+		pathResult:         pathResult,
+		cleanup:            cleanup,
+	}, nil
+}
 
-	// Set environment variables
-	pulumiCmd.Env = append(os.Environ(), pulumimodulestackinput.FilePathEnvVar+"="+finalStackInputFilePath)
+// env returns the environment of pulumi commands run against the stack.
+func (p *preparedStack) env(kubeContext string) []string {
+	env := append(os.Environ(), pulumimodulestackinput.FilePathEnvVar+"="+p.stackInputFilePath)
 	if kubeContext != "" {
-		pulumiCmd.Env = append(pulumiCmd.Env, "KUBE_CTX="+kubeContext)
+		env = append(env, "KUBE_CTX="+kubeContext)
 	}
+	return env
+}
 
-	// Set the working directory to the repository path
-	pulumiCmd.Dir = pulumiModuleRepoPath
-
-	// Set stdin, stdout, and stderr directly to the terminal for interactive output
-	// This allows Pulumi to detect TTY and use the interactive tree view
-	pulumiCmd.Stdin = os.Stdin
-	pulumiCmd.Stdout = os.Stdout
-	pulumiCmd.Stderr = os.Stderr
-
-	// Log execution mode and directory info (debug level only)
-	if pathResult.UseBinary {
+// logExecutionMode logs whether the module runs as a prebuilt binary (debug level only).
+func (p *preparedStack) logExecutionMode() {
+	if p.pathResult.UseBinary {
 		log.Debugf("execution mode: binary (no compilation)")
-		log.Debugf("binary path: %s", pathResult.BinaryPath)
+		log.Debugf("binary path: %s", p.pathResult.BinaryPath)
 	} else {
 		log.Debugf("execution mode: source (compilation required)")
 	}
-	log.Debugf("workspace directory: %s", pulumiModuleRepoPath)
-	fmt.Println()
-
-	// Print handoff message after all setup is complete
-	cliprint.PrintHandoff("Pulumi")
-
-	if err := pulumiCmd.Run(); err != nil {
-		return errors.Wrapf(err, "failed to execute pulumi command %s", op)
-	}
-
-	return nil
+	log.Debugf("workspace directory: %s", p.modulePath)
 }
@@ -0,0 +1,116 @@
+package tofumodule
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/apis/org/openmcf/shared/iac/terraform"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/iac/stackinput/stackinputproviderconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tfbackend"
+)
+
+// outputValue is a single entry of `tofu output -json`.
+type outputValue struct {
+	Sensitive bool            `json:"sensitive"`
+	Type      json.RawMessage `json:"type"`
+	Value     interface{}     `json:"value"`
+}
+
+// GetOutputs initializes the module against its state backend and returns the root module
+// outputs, keyed by output name, by running `<binary> output -json`.
+// The parameters mirror RunCommand so outputs are read from the same state the operations write to.
+func GetOutputs(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	valueOverrides []manifest.Override,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+) (map[string]interface{}, error) {
+	prepared, err := prepareModule(binaryName, inputModuleDir, targetManifestPath, valueOverrides,
+		moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer prepared.cleanup()
+
+	// Run init in JSON mode and discard its events to keep the terminal quiet
+	initEvents := make(chan string)
+	go func() {
+		for range initEvents {
+		}
+	}()
+	err = Init(binaryName, prepared.modulePath, prepared.manifestObject, prepared.backendType,
+		prepared.backendConfigArgs, prepared.providerConfigEnvVars, false, true, initEvents)
+	close(initEvents)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize %s module", binaryName)
+	}
+
+	return ReadOutputs(binaryName, prepared.modulePath, prepared.providerConfigEnvVars)
+}
+
+// ReadBackendOutputs returns the root module outputs stored in a remote state backend without
+// staging the module that wrote them. It initializes an empty configuration that only declares
+// the backend, so no providers are downloaded. Backend credentials are taken from the environment.
+func ReadBackendOutputs(binaryName string, backendConfig *backendconfig.TofuBackendConfig) (map[string]interface{}, error) {
+	backendType := tfbackend.BackendTypeFromString(backendConfig.BackendType)
+	if backendType == terraform.TerraformBackendType_terraform_backend_type_unspecified ||
+		backendType == terraform.TerraformBackendType_local {
+		return nil, errors.Errorf("outputs can only be read from a remote backend, not %q", backendConfig.BackendType)
+	}
+
+	dir, err := os.MkdirTemp("", "openmcf-backend-outputs-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temp directory")
+	}
+	defer os.RemoveAll(dir)
+
+	if err := tfbackend.WriteBackendFile(dir, backendType); err != nil {
+		return nil, err
+	}
+	args := []string{"init", "-input=false", "-no-color"}
+	for _, arg := range buildBackendConfigArgs(backendConfig) {
+		args = append(args, "--backend-config", arg)
+	}
+	if _, err := runCaptured(binaryName, dir, nil, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize %s backend", binaryName)
+	}
+	return ReadOutputs(binaryName, dir, nil)
+}
+
+// ReadOutputs returns the outputs of an already initialized module, keyed by output name.
+func ReadOutputs(binaryName, modulePath string, providerConfigEnvVars []string) (map[string]interface{}, error) {
+	cmd := exec.Command(binaryName, "output", "-json")
+	cmd.Dir = modulePath
+	cmd.Env = append(os.Environ(), providerConfigEnvVars...)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s outputs: %s", binaryName, strings.TrimSpace(stderr.String()))
+	}
+
+	raw := map[string]outputValue{}
+	if err := json.Unmarshal(stdout.Bytes(), &raw); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s outputs", binaryName)
+	}
+
+	outputs := make(map[string]interface{}, len(raw))
+	for name, output := range raw {
+		outputs[name] = output.Value
+	}
+	return outputs, nil
+}
@@ -0,0 +1,128 @@
+package tofumodule
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/plantonhq/openmcf/internal/manifest"
+	"github.com/plantonhq/openmcf/pkg/iac/stackinput/stackinputproviderconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
+	"github.com/plantonhq/openmcf/pkg/iac/tofu/tfvars"
+)
+
+// planFile is the plan file written by plan operations, relative to the module path.
+const planFile = "terraform.tfplan"
+
+// PlanJson initializes the module against its state backend, runs `<binary> plan` and returns
+// the JSON representation of the plan as printed by `<binary> show -json`. With isDestroyPlan,
+// the plan destroys all resources. The parameters mirror RunCommand.
+func PlanJson(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	valueOverrides []manifest.Override,
+	isDestroyPlan bool,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+) ([]byte, error) {
+	var planArgs []string
+	if isDestroyPlan {
+		planArgs = append(planArgs, "--destroy")
+	}
+	return planAndShow(binaryName, inputModuleDir, targetManifestPath, valueOverrides, moduleVersion, noCleanup,
+		kubeContext, providerConfig, backendConfig, planArgs...)
+}
+
+// RefreshOnlyPlanJson is like PlanJson but runs `<binary> plan -refresh-only`. The plan reads live
+// resources from the cloud but neither state nor resources are changed.
+func RefreshOnlyPlanJson(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	valueOverrides []manifest.Override,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+) ([]byte, error) {
+	return planAndShow(binaryName, inputModuleDir, targetManifestPath, valueOverrides, moduleVersion, noCleanup,
+		kubeContext, providerConfig, backendConfig, "-refresh-only", "-lock=false")
+}
+
+// planAndShow stages and initializes the module, saves a plan made with the extra planArgs and
+// returns the plan's JSON representation.
+func planAndShow(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	valueOverrides []manifest.Override,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+	planArgs ...string,
+) ([]byte, error) {
+	prepared, err := prepareModule(binaryName, inputModuleDir, targetManifestPath, valueOverrides,
+		moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer prepared.cleanup()
+
+	// Run init in JSON mode and discard its events to keep the terminal quiet
+	initEvents := make(chan string)
+	go func() {
+		for range initEvents {
+		}
+	}()
+	err = Init(binaryName, prepared.modulePath, prepared.manifestObject, prepared.backendType,
+		prepared.backendConfigArgs, prepared.providerConfigEnvVars, false, true, initEvents)
+	close(initEvents)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize %s module", binaryName)
+	}
+
+	tfVarsFile := filepath.Join(prepared.modulePath, ".terraform", "terraform.tfvars")
+	if err := tfvars.WriteVarFile(prepared.manifestObject, tfVarsFile); err != nil {
+		return nil, errors.Wrapf(err, "failed to write %s file", tfVarsFile)
+	}
+
+	args := append([]string{"plan", "-input=false", "--var-file", tfVarsFile, "--out", planFile}, planArgs...)
+	if _, err := runCaptured(binaryName, prepared.modulePath, prepared.providerConfigEnvVars, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to run %s plan", binaryName)
+	}
+
+	planJson, err := runCaptured(binaryName, prepared.modulePath, prepared.providerConfigEnvVars,
+		"show", "-json", planFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to show %s plan", binaryName)
+	}
+	return planJson, nil
+}
+
+// runCaptured runs a command in the module directory and returns its stdout. On failure the
+// error includes stderr, which holds the diagnostics.
+func runCaptured(binaryName, modulePath string, providerConfigEnvVars []string, args ...string) ([]byte, error) {
+	cmd := exec.Command(binaryName, args...)
+	cmd.Dir = modulePath
+	cmd.Env = append(os.Environ(), providerConfigEnvVars...)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "%s: %s", cmd.String(), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
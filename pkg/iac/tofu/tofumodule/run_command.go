@@ -13,6 +13,7 @@ import (
 	"github.com/plantonhq/openmcf/pkg/iac/tofu/backendconfig"
 	"github.com/plantonhq/openmcf/pkg/iac/tofu/tfbackend"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 // RunCommand executes an HCL-based IaC operation (init + operation) using the specified binary.
@@ -24,7 +25,7 @@ func RunCommand(
 	inputModuleDir string,
 	targetManifestPath string,
 	terraformOperation terraform.TerraformOperationType,
-	valueOverrides map[string]string,
+	valueOverrides []manifest.Override,
 	isAutoApprove bool,
 	isDestroyPlan bool,
 	isReconfigure bool,
@@ -34,9 +35,133 @@ func RunCommand(
 	providerConfig *stackinputproviderconfig.ProviderConfig,
 	backendConfig *backendconfig.TofuBackendConfig,
 ) error {
+	return RunCommandWithOutputs(binaryName, inputModuleDir, targetManifestPath, terraformOperation, valueOverrides,
+		isAutoApprove, isDestroyPlan, isReconfigure, moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig, nil)
+}
+
+// RunCommandWithOutputs is like RunCommand. After a successful operation it reads the root module
+// outputs while the module is still initialized and passes them, or the error reading them, to
+// onOutputs, so capturing outputs does not need a second init. A nil onOutputs skips reading.
+func RunCommandWithOutputs(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	terraformOperation terraform.TerraformOperationType,
+	valueOverrides []manifest.Override,
+	isAutoApprove bool,
+	isDestroyPlan bool,
+	isReconfigure bool,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+	onOutputs func(outputs map[string]interface{}, err error),
+) error {
+	return runCommand(binaryName, inputModuleDir, targetManifestPath, terraformOperation, valueOverrides,
+		isAutoApprove, isDestroyPlan, isReconfigure, moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig,
+		nil, onOutputs)
+}
+
+// RunCommandWithEvents is like RunCommandWithOutputs but runs init and the operation with -json and
+// sends each machine-readable log line to jsonLogEventsChan instead of the terminal, so callers
+// without a terminal can record the run. The caller must keep reading the channel until RunCommandWithEvents returns.
+func RunCommandWithEvents(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	terraformOperation terraform.TerraformOperationType,
+	valueOverrides []manifest.Override,
+	isAutoApprove bool,
+	isDestroyPlan bool,
+	isReconfigure bool,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+	jsonLogEventsChan chan string,
+	onOutputs func(outputs map[string]interface{}, err error),
+) error {
+	return runCommand(binaryName, inputModuleDir, targetManifestPath, terraformOperation, valueOverrides,
+		isAutoApprove, isDestroyPlan, isReconfigure, moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig,
+		jsonLogEventsChan, onOutputs)
+}
+
+// runCommand stages the module, runs init and the operation and reads the outputs. A nil
+// jsonLogEventsChan streams the binary's human-readable output to the terminal.
+func runCommand(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	terraformOperation terraform.TerraformOperationType,
+	valueOverrides []manifest.Override,
+	isAutoApprove bool,
+	isDestroyPlan bool,
+	isReconfigure bool,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+	jsonLogEventsChan chan string,
+	onOutputs func(outputs map[string]interface{}, err error),
+) error {
+	isJsonOutput := jsonLogEventsChan != nil
+
+	prepared, err := prepareModule(binaryName, inputModuleDir, targetManifestPath, valueOverrides,
+		moduleVersion, noCleanup, kubeContext, providerConfig, backendConfig)
+	if err != nil {
+		return err
+	}
+	defer prepared.cleanup()
+
+	// Initialize with backend configuration before any operation
+	err = Init(binaryName, prepared.modulePath, prepared.manifestObject, prepared.backendType,
+		prepared.backendConfigArgs, prepared.providerConfigEnvVars, isReconfigure, isJsonOutput, jsonLogEventsChan)
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s module", binaryName)
+	}
+
+	err = RunOperation(binaryName, prepared.modulePath, terraformOperation,
+		isAutoApprove, isDestroyPlan, prepared.manifestObject,
+		prepared.providerConfigEnvVars, isJsonOutput, jsonLogEventsChan)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run %s operation", binaryName)
+	}
+
+	if onOutputs != nil {
+		onOutputs(ReadOutputs(binaryName, prepared.modulePath, prepared.providerConfigEnvVars))
+	}
+	return nil
+}
+
+// preparedModule holds everything needed to run a command against a staged HCL module.
+type preparedModule struct {
+	manifestObject        proto.Message
+	modulePath            string
+	backendType           terraform.TerraformBackendType
+	backendConfigArgs     []string
+	providerConfigEnvVars []string
+	cleanup               func()
+}
+
+// prepareModule loads the manifest, resolves the backend configuration, stages the module
+// and builds the provider environment variables. The caller must run cleanup when done.
+func prepareModule(
+	binaryName string,
+	inputModuleDir string,
+	targetManifestPath string,
+	valueOverrides []manifest.Override,
+	moduleVersion string,
+	noCleanup bool,
+	kubeContext string,
+	providerConfig *stackinputproviderconfig.ProviderConfig,
+	backendConfig *backendconfig.TofuBackendConfig,
+) (*preparedModule, error) {
 	manifestObject, err := manifest.LoadWithOverrides(targetManifestPath, valueOverrides)
 	if err != nil {
-		return errors.Wrapf(err, "failed to override values in target manifest file")
+		return nil, errors.Wrapf(err, "failed to override values in target manifest file")
 	}
 
 	// Determine backend configuration:
@@ -50,7 +175,7 @@ func RunCommand(
 		if backendConfig.BackendType != "" {
 			backendType = tfbackend.BackendTypeFromString(backendConfig.BackendType)
 			if backendType == terraform.TerraformBackendType_terraform_backend_type_unspecified {
-				return errors.Errorf("unsupported backend type: %s", backendConfig.BackendType)
+				return nil, errors.Errorf("unsupported backend type: %s", backendConfig.BackendType)
 			}
 			backendConfigArgs = buildBackendConfigArgs(backendConfig)
 		}
@@ -67,7 +192,7 @@ func RunCommand(
 			// Convert backend type string to enum
 			backendType = tfbackend.BackendTypeFromString(tofuBackendConfig.BackendType)
 			if backendType == terraform.TerraformBackendType_terraform_backend_type_unspecified {
-				return errors.Errorf("unsupported backend type from manifest labels: %s", tofuBackendConfig.BackendType)
+				return nil, errors.Errorf("unsupported backend type from manifest labels: %s", tofuBackendConfig.BackendType)
 			}
 
 			// Build backend config arguments based on backend type
@@ -79,56 +204,53 @@ func RunCommand(
 
 	kindName, err := crkreflect.ExtractKindFromProto(manifestObject)
 	if err != nil {
-		return errors.Wrapf(err, "failed to extract kind name from manifest proto")
+		return nil, errors.Wrapf(err, "failed to extract kind name from manifest proto")
 	}
 
 	// Get module path using staging-based approach
 	pathResult, err := GetModulePath(inputModuleDir, kindName, moduleVersion, noCleanup)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get %s module directory", binaryName)
+		return nil, errors.Wrapf(err, "failed to get %s module directory", binaryName)
 	}
 
 	// Setup cleanup to run after execution
+	cleanup := func() {}
 	if pathResult.ShouldCleanup {
-		defer func() {
+		cleanup = func() {
 			if cleanupErr := pathResult.CleanupFunc(); cleanupErr != nil {
 				fmt.Printf("Warning: failed to cleanup workspace copy: %v\n", cleanupErr)
 			}
-		}()
+		}
 	}
 
 	modulePath := pathResult.ModulePath
 
 	stackInputYaml, err := stackinput.BuildStackInputYaml(manifestObject, providerConfig)
 	if err != nil {
-		return errors.Wrap(err, "failed to build stack input yaml")
+		cleanup()
+		return nil, errors.Wrap(err, "failed to build stack input yaml")
 	}
 
 	workspaceDir, err := workspace.GetWorkspaceDir()
 	if err != nil {
-		return errors.Wrap(err, "failed to get workspace directory")
+		cleanup()
+		return nil, errors.Wrap(err, "failed to get workspace directory")
 	}
 
 	providerConfigEnvVars, err := GetProviderConfigEnvVars(stackInputYaml, workspaceDir, kubeContext)
 	if err != nil {
-		return errors.Wrap(err, "failed to get provider config env vars")
+		cleanup()
+		return nil, errors.Wrap(err, "failed to get provider config env vars")
 	}
 
-	// Initialize with backend configuration before any operation
-	err = Init(binaryName, modulePath, manifestObject, backendType, backendConfigArgs,
-		providerConfigEnvVars, isReconfigure, false, nil)
-	if err != nil {
-		return errors.Wrapf(err, "failed to initialize %s module", binaryName)
-	}
-
-	err = RunOperation(binaryName, modulePath, terraformOperation,
-		isAutoApprove, isDestroyPlan, manifestObject,
-		providerConfigEnvVars, false, nil)
-	if err != nil {
-		return errors.Wrapf(err, "failed to run %s operation", binaryName)
-	}
-
-	return nil
+	return &preparedModule{
+		manifestObject:        manifestObject,
+		modulePath:            modulePath,
+		backendType:           backendType,
+		backendConfigArgs:     backendConfigArgs,
+		providerConfigEnvVars: providerConfigEnvVars,
+		cleanup:               cleanup,
+	}, nil
 }
 
 // buildBackendConfigArgs builds backend configuration arguments based on backend type.
@@ -187,8 +309,10 @@ func buildBackendConfigArgs(config *backendconfig.TofuBackendConfig) []string {
 		}
 
 	case "local":
-		// Local backend doesn't need config args
-		// The path is handled by terraform itself
+		// Local backend only needs a path when the state file should live outside the module directory
+		if config.BackendKey != "" {
+			args = append(args, fmt.Sprintf("path=%s", config.BackendKey))
+		}
 	}
 
 	return args
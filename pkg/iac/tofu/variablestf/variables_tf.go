@@ -107,9 +107,9 @@ func ProtoToVariablesTF(msg proto.Message) (string, error) {
 			return "", errors.Wrapf(err, "failed to convert field %q to terraform type", fieldName)
 		}
 
-		desc := findFieldDescription(apiDocsJson, string(md.FullName()), fieldName)
+		desc := apidocs.FieldDescription(apiDocsJson, string(md.FullName()), fieldName)
 		if desc == "" && fd.Kind() == protoreflect.MessageKind {
-			desc = findMessageDescription(apiDocsJson, string(fd.Message().FullName()))
+			desc = apidocs.MessageDescription(apiDocsJson, string(fd.Message().FullName()))
 		}
 
 		if desc == "" {
@@ -199,9 +199,9 @@ func messageToTerraformObject(md protoreflect.MessageDescriptor, fd protoreflect
 		}
 		snakeKey := caseconverter.ToSnakeCase(fieldName)
 
-		desc := findFieldDescription(apiDocsJson, parentFullName, fieldName)
+		desc := apidocs.FieldDescription(apiDocsJson, parentFullName, fieldName)
 		if desc == "" && f.Kind() == protoreflect.MessageKind {
-			desc = findMessageDescription(apiDocsJson, string(f.Message().FullName()))
+			desc = apidocs.MessageDescription(apiDocsJson, string(f.Message().FullName()))
 		}
 		if desc == "" {
 			desc = fieldDescriptions[fieldName]
@@ -219,41 +219,6 @@ func messageToTerraformObject(md protoreflect.MessageDescriptor, fd protoreflect
 	return obj, nil
 }
 
-func findMessageDescription(apiDocsJson *gendoc.Template, fullName string) string {
-	if apiDocsJson == nil {
-		return ""
-	}
-
-	for _, f := range apiDocsJson.Files {
-		for _, m := range f.Messages {
-			if m.FullName == fullName {
-				return strings.TrimSpace(m.Description)
-			}
-		}
-	}
-	return ""
-}
-
-func findFieldDescription(apiDocsJson *gendoc.Template, messageFullName, fieldName string) string {
-	if apiDocsJson == nil {
-		return ""
-	}
-
-	for _, f := range apiDocsJson.Files {
-		for _, m := range f.Messages {
-			if m.FullName == messageFullName {
-				for _, fld := range m.Fields {
-					if fld.Name == fieldName {
-						return strings.TrimSpace(fld.Description)
-					}
-				}
-			}
-		}
-	}
-
-	return ""
-}
-
 // isWellKnownJsonType returns true for protobuf well-known types representing JSON
 // so we map them to primitive string in Terraform variable schema
 func isWellKnownJsonType(fullName string) bool {